@@ -15,6 +15,17 @@ var _ Address = &CosmosAddress{}
 var _ Address = &EvmAddress{}
 var _ Address = &SolanaAddress{}
 var _ Address = &SuiAddress{}
+var _ Address = &StarknetAddress{}
+var _ Address = &AptosAddress{}
+var _ Address = &TonAddress{}
+var _ Address = &TronAddress{}
+var _ Address = &StellarAddress{}
+var _ Address = &XrplAddress{}
+var _ Address = &SS58Address{}
+var _ Address = &NearAddress{}
+var _ Address = &CardanoAddress{}
+var _ Address = &AlgorandAddress{}
+var _ Address = &UtxoAddress{}
 var _ Address = &GenericAddress{}
 
 var ErrEmptyAddress = fmt.Errorf("empty address")
@@ -28,13 +39,21 @@ type Address interface {
 	// Bytes returns the bytes of the address according to the length and format of the respective ecosystem. Value is
 	// a copy of the inner representation so it is safe to modify
 	Bytes() []byte
+	// Bytes32 returns the canonical 32 bytes representation of the address used in Lombard payloads, left padded
+	// with zeroes according to the rules of the ecosystem. An ErrNotRepresentableBytes32 is returned for addresses
+	// that cannot be decoded back losslessly. See NewAddressFromBytes32.
+	Bytes32() ([Bytes32Length]byte, error)
+	// Key returns the comparable representation of the address, which can be used as map key. Addresses of the
+	// same type have the same key if and only if they are Equal.
+	Key() AddressKey
 	// Hex return the hex encoded version of the bytes of the address
 	Hex() string
 	// Length returns the lenght of the address when referencing it as an array of bytes
 	Length() int
 	// Ecosystem returns the ecosystem the adress belongs to according to the Lombard internal reference
 	Ecosystem() chainid.Ecosystem
-	// Equal verifies if the passed address is the same of the current instance
+	// Equal verifies if the passed address is the same of the current instance. Nil addresses are only equal to
+	// each other.
 	Equal(a2 Address) bool
 }
 
@@ -45,20 +64,10 @@ var ErrUnsupportedEcosystem = fmt.Errorf("ecosystem is unsupported")
 // NewAddress creates a new Address instance from a slice of bytes whose concrete implementation
 // is defined by the provided ecosystem
 func NewAddress(b []byte, e chainid.Ecosystem) (Address, error) {
-	switch e {
-	case chainid.EcosystemEVM:
-		return NewEvmAddress(b)
-	case chainid.EcosystemSui:
-		return NewSuiAddress(b)
-	case chainid.EcosystemSolana:
-		return NewSolanaAddress(b)
-	case chainid.EcosystemCosmos:
-		return NewCosmosAddress(b)
-	case chainid.EcosystemStarknet:
-		return NewStarknetAddress(b)
-	default:
-		return NewGenericAddress(b, e)
+	if codec, ok := LookupCodec(e); ok {
+		return codec.FromBytes(b)
 	}
+	return NewGenericAddress(b, e)
 }
 
 // NewAddressFromHex creates a new Address instance from an hex string of bytes whose concrete implementation
@@ -72,14 +81,24 @@ func NewAddressFromHex(address string, e chainid.Ecosystem) (Address, error) {
 }
 
 // NewAddressFromString creates a new Address from a generic string, interpreted according to the ecosystem.
-// Hex (with optional '0x') for all chains except Solana, where base58 is used.
+// Hex (with optional '0x') is used for all chains except:
+//   - Solana, where base58 is used
+//   - Aptos, where the AIP-40 short form is accepted for special addresses
+//   - TON, where both the raw and the user-friendly forms are accepted
+//   - Tron, where both the base58check and the hex forms are accepted
+//   - Stellar, where StrKey is used
+//   - XRP Ledger, where both the classic and the X-address forms are accepted
+//   - Substrate, where SS58 with any network prefix is used and hex requires the leading '0x'
+//   - NEAR, where the account id is used as-is
+//   - Cardano, where bech32 is used
+//   - Algorand, where base32 with checksum is used
+//
+// Ecosystems registered with RegisterCodec use their own string parser, if any.
 func NewAddressFromString(address string, e chainid.Ecosystem) (Address, error) {
-	switch e {
-	case chainid.EcosystemSolana:
-		return NewSolanaAddressFromBase58(address)
-	default:
-		return NewAddressFromHex(address, e)
+	if codec, ok := LookupCodec(e); ok && codec.FromString != nil {
+		return codec.FromString(address)
 	}
+	return NewAddressFromHex(address, e)
 }
 
 // GenericAddress does not enforce any ecosystem specific check, leaving to the caller
@@ -124,6 +143,25 @@ func (a *GenericAddress) Bytes() []byte {
 	return buf
 }
 
+// Bytes32 returns the bytes of the address, which must be 32 bytes long since generic addresses are decoded
+// as-is. Addresses of the Bitcoin ecosystem are output scripts, following the rules of UtxoAddress.
+func (a *GenericAddress) Bytes32() ([Bytes32Length]byte, error) {
+	if a.ecosystem == chainid.EcosystemBitcoin {
+		return bitcoinBytes32(a.inner)
+	}
+	if len(a.inner) != Bytes32Length {
+		return [Bytes32Length]byte{}, fmt.Errorf(
+			"%w: generic address of %d bytes", ErrNotRepresentableBytes32, len(a.inner),
+		)
+	}
+	return [Bytes32Length]byte(a.inner), nil
+}
+
+// Key returns the comparable representation of the address
+func (a *GenericAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
 func (a *GenericAddress) Length() int {
 	return len(a.inner)
 }
@@ -133,6 +171,9 @@ func (a *GenericAddress) Ecosystem() chainid.Ecosystem {
 }
 
 func (a1 *GenericAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
 	if a2.Ecosystem() != a1.Ecosystem() {
 		return false
 	}
@@ -140,12 +181,9 @@ func (a1 *GenericAddress) Equal(a2 Address) bool {
 }
 
 func NewZeroAddress(e chainid.Ecosystem) Address {
-	switch e {
-	case chainid.EcosystemEVM:
-		addr, _ := NewEvmAddress(common.Bytes32Zeros[:EvmAddressLength])
-		return addr
-	default:
-		addr, _ := NewAddress(common.Bytes32Zeros, e)
-		return addr
+	if codec, ok := LookupCodec(e); ok && codec.Zero != nil {
+		return codec.Zero()
 	}
+	addr, _ := NewAddress(common.Bytes32Zeros, e)
+	return addr
 }
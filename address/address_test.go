@@ -2,6 +2,11 @@ package address_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -18,7 +23,7 @@ func TestNewAddress(t *testing.T) {
 	cosmosAddressString20Bytes := "4AF2A0E44F9CD6F5E2FD5F0C06BC230AF3EF688C"
 	cosmosAddressString32Bytes := "1A9568EC8F8E3F6740E1BCAE9C6233256812C4B775AEF4BE8E913EAF76243E1D"
 	genericValidAddressString := "0x3e8e9423d80e1774a7ca128fccd8bf5f1f7753be658c5e645929037f7c819040889955ef"
-	anotherEcosystem := chainid.Ecosystem(11)
+	anotherEcosystem := chainid.Ecosystem(200)
 
 	evmAddress, err := address.NewAddressFromHex(evmAddressString, chainid.EcosystemEVM)
 	common.AssertNoError(t, err)
@@ -88,6 +93,34 @@ func TestEVMAddress(t *testing.T) {
 		common.AssertTrue(t, addr.Equal(noChecksumAddr))
 	})
 
+	t.Run("should handle eip-55 checksum", func(t *testing.T) {
+		addr, err := address.NewEvmAddressFromHex(validAddressString)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, validAddressString, addr.ChecksumString())
+		// EIP-55 test vectors
+		for _, s := range []string{
+			"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+			"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+			"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		} {
+			addr, err := address.NewEvmAddressFromChecksumHex(s)
+			common.AssertNoError(t, err)
+			common.EqualStrings(t, s, addr.ChecksumString())
+		}
+
+		lower, err := address.NewEvmAddressFromHex(strings.ToLower(validAddressString))
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, validAddressString, lower.ChecksumString())
+		_, err = address.NewEvmAddressFromChecksumHex(strings.ToLower(validAddressString))
+		common.AssertError(t, err, address.ErrBadAddressEvm, address.ErrBadChecksumEvm)
+		_, err = address.NewEvmAddressFromChecksumHex("0x8236A87084f8B84306f72007F36F2618A5634494")
+		common.AssertError(t, err, address.ErrBadAddressEvm, address.ErrBadChecksumEvm)
+		// without leading 0x
+		_, err = address.NewEvmAddressFromChecksumHex(validAddressString[2:])
+		common.AssertNoError(t, err)
+	})
+
 	t.Run("should reject invalid addresses", func(t *testing.T) {
 
 		// shorter address
@@ -199,6 +232,9 @@ func TestSolanaAddress(t *testing.T) {
 		// longer address
 		_, err = address.NewSolanaAddressFromBase58(validAddressString + validAddressString[3:])
 		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSolana)
+		// oversized address
+		_, err = address.NewSolanaAddressFromBase58(strings.Repeat(validAddressString, 1000))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSolana, base58.ErrInvalidLength)
 		// non base58 char
 		_, err = address.NewSolanaAddressFromBase58(validAddressString[:5] + "0" + validAddressString[6:])
 		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSolana)
@@ -243,6 +279,110 @@ func TestCosmosAddress(t *testing.T) {
 	})
 }
 
+func TestCosmosModuleAddress(t *testing.T) {
+	t.Run("should match cosmos sdk module accounts", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			expected string
+		}{
+			// cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn
+			{"gov", "7b5fe22b5446f7c62ea27b8bd71cef94e03f3df2"},
+			// cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta
+			{"fee_collector", "f1829676db577682e944fc3493d451b67ff3e29f"},
+			// cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl
+			{"distribution", "93354845030274cd4bf1686abd60ab28ec52e1a7"},
+			// cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh
+			{"bonded_tokens_pool", "4fea76427b8345861e80a3540a8a9d936fd39391"},
+		}
+		for _, tt := range tests {
+			addr := address.NewCosmosModuleAddress(tt.name)
+			common.EqualStrings(t, tt.expected, addr.Hex())
+			common.AssertTrue(t, address.CosmosSdkAddressLength == addr.Length())
+		}
+	})
+
+	t.Run("should derive module sub-accounts", func(t *testing.T) {
+		// the classic address of the first wasm contract is Module("wasm", codeID || instanceID)
+		contractId, _ := hex.DecodeString("00000000000000010000000000000001")
+		addr := address.NewCosmosModuleAddress("wasm", contractId)
+		common.AssertTrue(t, address.NewCosmWasmClassicAddress(1, 1).Equal(addr))
+
+		// Module(name, k1, k2) == Derive(Module(name, k1), k2)
+		nested := address.NewCosmosModuleAddress("wasm", contractId, []byte("sub"))
+		common.AssertTrue(t, address.CosmWasmAddressLength == nested.Length())
+		derived, err := address.NewCosmosDerivedAddress(addr, []byte("sub"))
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, derived.Equal(nested))
+		_, err = address.NewCosmosDerivedAddress(nil, []byte("sub"))
+		common.AssertError(t, err, address.ErrBadAddressCosmos, address.ErrEmptyAddress)
+		common.AssertFalse(t, addr.Equal(nested))
+
+		// Module(name, k) == Hash("module", name || 0x00 || k)
+		hashed := address.NewCosmosHashAddress("module", append([]byte("wasm\x00"), contractId...))
+		common.AssertTrue(t, hashed.Equal(addr))
+	})
+}
+
+func TestCosmosAddressBech32(t *testing.T) {
+	bech32String := "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
+
+	addr, err := address.NewCosmosAddressFromBech32(bech32String, "cosmos")
+	common.AssertNoError(t, err)
+	common.EqualStrings(t, "4fea76427b8345861e80a3540a8a9d936fd39391", addr.Hex())
+	encoded, err := addr.Bech32("cosmos")
+	common.AssertNoError(t, err)
+	common.EqualStrings(t, bech32String, encoded)
+
+	// wrong prefix
+	_, err = address.NewCosmosAddressFromBech32(bech32String, "osmo")
+	common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCosmos)
+	// wrong checksum
+	_, err = address.NewCosmosAddressFromBech32(bech32String[:len(bech32String)-1]+"q", "cosmos")
+	common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCosmos)
+}
+
+func TestEvmCosmosConversion(t *testing.T) {
+	evmAddr, err := address.NewEvmAddressFromHex("0x8236a87084f8B84306f72007F36F2618A5634494")
+	common.AssertNoError(t, err)
+
+	t.Run("should convert between evm and cosmos addresses", func(t *testing.T) {
+		cosmosAddr := address.EvmToCosmosAddress(evmAddr)
+		common.EqualBytes(t, evmAddr.Bytes(), cosmosAddr.Bytes())
+		back, err := address.CosmosToEvmAddress(cosmosAddr)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, evmAddr.Equal(back))
+
+		// CosmWasm addresses are refused
+		_, err = address.CosmosToEvmAddress(address.NewCosmWasmClassicAddress(1, 1))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrNotEvmConvertible)
+	})
+
+	t.Run("should use the bech32 prefix of evm compatible chains", func(t *testing.T) {
+		encoded, err := address.EvmToCosmosBech32(evmAddr, chainid.NewEvmosLChainId())
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "evmos1sgm2suyylzuyxphhyqrlxmexrzjkx3y5nxkkyp", encoded)
+		encoded, err = address.EvmToCosmosBech32(evmAddr, chainid.NewInjectiveLChainId())
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "inj1sgm2suyylzuyxphhyqrlxmexrzjkx3y5mwsuv3", encoded)
+
+		decoded, err := address.NewEvmAddressFromCosmosBech32(encoded, chainid.NewInjectiveLChainId())
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, evmAddr.Equal(decoded))
+
+		// prefix of another chain
+		_, err = address.NewEvmAddressFromCosmosBech32(encoded, chainid.NewEvmosLChainId())
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCosmos)
+	})
+
+	t.Run("should refuse chains that are not evm compatible", func(t *testing.T) {
+		_, err := address.EvmToCosmosBech32(evmAddr, chainid.NewCosmosHubLChainId())
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrNotEvmConvertible)
+		unknown, _ := chainid.NewCosmosLChainId("unknown-1")
+		_, err = address.EvmToCosmosBech32(evmAddr, unknown)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrNotEvmConvertible)
+	})
+}
+
 func TestStarknetAddress(t *testing.T) {
 	validAddressString := "0x049d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7"
 	anotherValidAddressString := "0x0213c67ed78bc280887234fe5ed5e77272465317978ae86c25a71531d9332a2d"
@@ -283,11 +423,1670 @@ func TestStarknetAddress(t *testing.T) {
 	})
 }
 
+func TestAptosAddress(t *testing.T) {
+	validAddressString := "0x00000000000000000000000000000000000000000000000000000000000000a1"
+	anotherValidAddressString := "0x0213c67ed78bc280887234fe5ed5e77272465317978ae86c25a71531d9332a2d"
+
+	t.Run("should create address from valid hex addresses", func(t *testing.T) {
+		addr, err := address.NewAptosAddressFromHex(validAddressString)
+		common.AssertNoError(t, err)
+		// non special addresses are displayed in long form even with leading zeroes
+		common.EqualStrings(t, validAddressString, addr.String())
+		common.AssertFalse(t, addr.IsSpecial())
+		equalEcosystem(t, chainid.EcosystemAptos, addr.Ecosystem())
+		common.AssertTrue(t, address.AptosAddressLength == addr.Length())
+		// Same address without leading 0x
+		addrNo0x, err := address.NewAptosAddressFromHex(validAddressString[2:])
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, addr.Equal(addrNo0x))
+		// Check with different addresses
+		differentAddr, err := address.NewAptosAddressFromHex(anotherValidAddressString)
+		common.AssertNoError(t, err)
+		common.AssertFalse(t, differentAddr.Equal(addr))
+	})
+
+	t.Run("should handle special addresses in short form", func(t *testing.T) {
+		short, err := address.NewAptosAddressFromHex("0x1")
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, short.IsSpecial())
+		common.EqualStrings(t, "0x1", short.String())
+		common.EqualStrings(t, "00"+common.Repeated64Zeros[4:]+"01", short.Hex())
+		long, err := address.NewAptosAddressFromHex("0x" + common.Repeated64Zeros[1:] + "1")
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, short.Equal(long))
+		common.EqualStrings(t, "0x1", long.String())
+
+		upper, err := address.NewAptosAddressFromHex("0xf")
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "0xf", upper.String())
+		common.EqualStrings(t, "0x0", address.NewZeroAddress(chainid.EcosystemAptos).String())
+
+		fromString, err := address.NewAddressFromString("0xa", chainid.EcosystemAptos)
+		common.AssertNoError(t, err)
+		_, ok := fromString.(*address.AptosAddress)
+		common.AssertTrue(t, ok)
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// short form of non special address
+		_, err := address.NewAptosAddressFromHex("0xa1")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAptos)
+		// shorter address
+		_, err = address.NewAptosAddressFromHex(validAddressString[5:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAptos)
+		// longer address
+		_, err = address.NewAptosAddressFromHex(validAddressString + anotherValidAddressString[2:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAptos)
+		// arbitrary char
+		_, err = address.NewAptosAddressFromHex("0xg")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAptos)
+		_, err = address.NewAptosAddressFromHex(validAddressString[:5] + "K" + validAddressString[6:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAptos)
+	})
+}
+
+func TestTonAddress(t *testing.T) {
+	bounceable := "EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2"
+	nonBounceable := "UQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p9dz"
+	testnetBounceable := "kQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74pzE8"
+	testnetNonBounceable := "0QDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p2z5"
+	raw := "0:ed1691307050047117b998b561d8de82d31fbf84910ced6eb5fc92e7485ef8a7"
+	electorRaw := "-1:3333333333333333333333333333333333333333333333333333333333333333"
+	elector := "Ef8zMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzM0vF"
+
+	t.Run("should parse and render raw and user-friendly forms", func(t *testing.T) {
+		fromRaw, err := address.NewTonAddressFromString(raw)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, raw, fromRaw.Raw())
+		common.EqualStrings(t, bounceable, fromRaw.String())
+		equalEcosystem(t, chainid.EcosystemTon, fromRaw.Ecosystem())
+		common.AssertTrue(t, address.TonAddressLength == fromRaw.Length())
+		common.EqualStrings(t, "00"+raw[2:], fromRaw.Hex())
+
+		tests := []struct {
+			userFriendly string
+			bounceable   bool
+			testnetOnly  bool
+		}{
+			{bounceable, true, false},
+			{nonBounceable, false, false},
+			{testnetBounceable, true, true},
+			{testnetNonBounceable, false, true},
+		}
+		for _, tt := range tests {
+			addr, err := address.NewTonAddressFromString(tt.userFriendly)
+			common.AssertNoError(t, err)
+			common.AssertTrue(t, tt.bounceable == addr.IsBounceable())
+			common.AssertTrue(t, tt.testnetOnly == addr.IsTestnetOnly())
+			common.EqualStrings(t, tt.userFriendly, addr.String())
+			common.EqualStrings(t, raw, addr.Raw())
+			// flags do not matter for equality
+			common.AssertTrue(t, fromRaw.Equal(addr))
+			common.EqualStrings(t, tt.userFriendly, fromRaw.WithFlags(tt.bounceable, tt.testnetOnly).String())
+		}
+
+		masterchain, err := address.NewAddressFromString(elector, chainid.EcosystemTon)
+		common.AssertNoError(t, err)
+		tonMasterchain, ok := masterchain.(*address.TonAddress)
+		common.AssertTrue(t, ok)
+		common.AssertTrue(t, tonMasterchain.Workchain() == -1)
+		common.EqualStrings(t, electorRaw, tonMasterchain.Raw())
+		common.AssertFalse(t, tonMasterchain.Equal(fromRaw))
+
+		// standard base64 alphabet
+		std, err := address.NewTonAddressFromUserFriendly(strings.NewReplacer("-", "+", "_", "/").Replace(bounceable))
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, std.Equal(fromRaw))
+	})
+
+	t.Run("should create address from bytes", func(t *testing.T) {
+		fromRaw, _ := address.NewTonAddressFromRaw(raw)
+		addr, err := address.NewTonAddress(fromRaw.Bytes())
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, fromRaw.Equal(addr))
+		hash := fromRaw.Hash()
+		addr, err = address.NewTonAddress(hash[:])
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, fromRaw.Equal(addr))
+		_, err = address.NewTonAddress(hash[1:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTon)
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// checksum mismatch
+		_, err := address.NewTonAddressFromString(bounceable[:47] + "3")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTon)
+		// shorter address
+		_, err = address.NewTonAddressFromString(bounceable[1:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTon)
+		// invalid base64
+		_, err = address.NewTonAddressFromString("*" + bounceable[1:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTon)
+		// workchain out of range
+		_, err = address.NewTonAddressFromString("300" + raw[1:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTon)
+		// shorter hash
+		_, err = address.NewTonAddressFromString(raw[:len(raw)-2])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTon)
+		// invalid hex
+		_, err = address.NewTonAddressFromString(raw[:5] + "K" + raw[6:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTon)
+	})
+}
+
+func TestTronAddress(t *testing.T) {
+	validBase58 := "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	validTronHex := "41a614f803b6fd780986a42c78ec9c7f77e6ded13c"
+	validEvmHex := "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c"
+
+	t.Run("should create address from valid strings", func(t *testing.T) {
+		addr, err := address.NewTronAddressFromBase58(validBase58)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, validBase58, addr.String())
+		common.EqualStrings(t, validTronHex, addr.TronHex())
+		common.EqualStrings(t, validEvmHex[2:], addr.Hex())
+		equalEcosystem(t, chainid.EcosystemTron, addr.Ecosystem())
+		common.AssertTrue(t, address.TronAddressLength == addr.Length())
+
+		fromTronHex, err := address.NewTronAddressFromHex(validTronHex)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, addr.Equal(fromTronHex))
+		fromEvmHex, err := address.NewAddressFromString(validEvmHex, chainid.EcosystemTron)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, addr.Equal(fromEvmHex))
+		fromString, err := address.NewAddressFromString(validBase58, chainid.EcosystemTron)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, addr.Equal(fromString))
+		common.EqualStrings(t, "T9yD14Nj9j7xAB4dbGeiX9h8unkKHxuWwb", address.NewZeroAddress(chainid.EcosystemTron).String())
+	})
+
+	t.Run("should convert from and to evm addresses", func(t *testing.T) {
+		addr, _ := address.NewTronAddressFromBase58(validBase58)
+		evmAddr := address.TronToEvmAddress(addr)
+		common.EqualStrings(t, validEvmHex, evmAddr.String())
+		common.AssertTrue(t, addr.Equal(address.EvmToTronAddress(evmAddr)))
+		// different ecosystems are never equal
+		common.AssertFalse(t, addr.Equal(evmAddr))
+		common.AssertFalse(t, evmAddr.Equal(addr))
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// checksum mismatch
+		_, err := address.NewTronAddressFromBase58(validBase58[:len(validBase58)-1] + "u")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTron, base58.ErrChecksum)
+		// bitcoin address has a different prefix
+		_, err = address.NewTronAddressFromBase58("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTron)
+		// wrong prefix in hex form
+		_, err = address.NewTronAddressFromHex("42" + validTronHex[2:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTron)
+		// shorter address
+		_, err = address.NewTronAddressFromHex(validTronHex[:20])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTron)
+		// non base58 char
+		_, err = address.NewTronAddressFromString(validBase58[:5] + "0" + validBase58[6:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressTron)
+	})
+}
+
+func TestStellarAddress(t *testing.T) {
+	account := "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"
+	accountKey := "3f0c34bf93ad0d9971d04ccc90f705511c838aad9734a4a2fb0d7a03fc7fe89a"
+	muxed := "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLK"
+	muxedZero := "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUQ"
+	contract := "CA3D5KRYM6CB7OWQ6TWYRR3Z4T7GNZLKERYNZGGA5SOAOPIFY6YQGAXE"
+	contractId := "363eaa3867841fbad0f4ed88c779e4fe66e56a2470dc98c0ec9c073d05c7b103"
+
+	t.Run("should parse and render the different kinds of address", func(t *testing.T) {
+		accountAddr, err := address.NewStellarAddressFromStrKey(account)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, address.StellarAccount == accountAddr.Kind())
+		common.EqualStrings(t, account, accountAddr.String())
+		common.EqualStrings(t, "30"+accountKey, accountAddr.Hex())
+		equalEcosystem(t, chainid.EcosystemStellar, accountAddr.Ecosystem())
+		common.AssertTrue(t, 33 == accountAddr.Length())
+		_, isMuxed := accountAddr.MuxedId()
+		common.AssertFalse(t, isMuxed)
+
+		muxedAddr, err := address.NewStellarAddressFromStrKey(muxed)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, address.StellarMuxedAccount == muxedAddr.Kind())
+		common.EqualStrings(t, muxed, muxedAddr.String())
+		id, isMuxed := muxedAddr.MuxedId()
+		common.AssertTrue(t, isMuxed)
+		common.AssertTrue(t, id == 9223372036854775808)
+		common.AssertTrue(t, 41 == muxedAddr.Length())
+		common.AssertFalse(t, muxedAddr.Equal(accountAddr))
+		common.AssertTrue(t, muxedAddr.BaseAccount().Equal(accountAddr))
+
+		muxedZeroAddr, err := address.NewStellarAddressFromStrKey(muxedZero)
+		common.AssertNoError(t, err)
+		id, _ = muxedZeroAddr.MuxedId()
+		common.AssertTrue(t, id == 0)
+		common.AssertFalse(t, muxedZeroAddr.Equal(muxedAddr))
+
+		contractAddr, err := address.NewStellarAddressFromStrKey(contract)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, address.StellarContract == contractAddr.Kind())
+		common.EqualStrings(t, contract, contractAddr.String())
+		key := contractAddr.RawKey()
+		common.EqualStrings(t, contractId, hex.EncodeToString(key[:]))
+	})
+
+	t.Run("should create address from bytes", func(t *testing.T) {
+		keyBytes, _ := hex.DecodeString(accountKey)
+		fromKey, err := address.NewAddress(keyBytes, chainid.EcosystemStellar)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, account, fromKey.String())
+		muxedAddr, err := address.NewStellarMuxedAddress(keyBytes, 0)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, muxedZero, muxedAddr.String())
+		fromBytes, err := address.NewStellarAddress(muxedAddr.Bytes())
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, fromBytes.Equal(muxedAddr))
+
+		idBytes, _ := hex.DecodeString(contractId)
+		contractAddr, err := address.NewStellarContractAddress(idBytes)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, contract, contractAddr.String())
+		// same bytes of different kinds are different addresses
+		accountAddr, err := address.NewStellarAccountAddress(idBytes)
+		common.AssertNoError(t, err)
+		common.AssertFalse(t, accountAddr.Equal(contractAddr))
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// checksum mismatch
+		_, err := address.NewStellarAddressFromStrKey(account[:len(account)-1] + "A")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressStellar)
+		// shorter address
+		_, err = address.NewStellarAddressFromStrKey(account[:len(account)-8])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressStellar)
+		// invalid base32 char
+		_, err = address.NewStellarAddressFromStrKey(account[:5] + "1" + account[6:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressStellar)
+		// lower case
+		_, err = address.NewStellarAddressFromStrKey(strings.ToLower(account))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressStellar)
+		// secret seeds (S...) are not addresses
+		_, err = address.NewStellarAddressFromStrKey("SBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWHOKR")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressStellar)
+		// payload length not matching the kind
+		_, err = address.NewStellarAddress(append([]byte{byte(address.StellarMuxedAccount)}, make([]byte, 32)...))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressStellar)
+	})
+}
+
+func TestXrplAddress(t *testing.T) {
+	classic := "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"
+	account := "5e7b112523f68d2f5e879db4eac51c6698a69304"
+	xAddress := "X7AcgcsBL6XDcUb289X4mJ8djcdyKaB5hJDWMArnXr61cqZ"
+	xAddressTestnet := "T719a5UwUCnEs54UsxG9CJYYDhwmFCqkr7wxCcNcfZ6p5GZ"
+	xAddressTag1 := "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu"
+	xAddressTag1Testnet := "T719a5UwUCnEs54UsxG9CJYYDhwmFCvbJNZbi37gBGkRkbE"
+	xAddressTag11747 := "X7AcgcsBL6XDcUb289X4mJ8djcdyKaLFuhLRuNXPrDeJd9A"
+
+	t.Run("should parse classic addresses", func(t *testing.T) {
+		addr, err := address.NewXrplAddressFromClassic(classic)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, classic, addr.String())
+		common.EqualStrings(t, account, addr.Hex())
+		equalEcosystem(t, chainid.EcosystemXrpl, addr.Ecosystem())
+		common.AssertTrue(t, 20 == addr.Length())
+		_, hasTag := addr.Tag()
+		common.AssertFalse(t, hasTag)
+
+		other, err := address.NewAddressFromString("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", chainid.EcosystemXrpl)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "b5f762798a53d543a014caf8b297cff8f2f937e8", other.Hex())
+		common.AssertFalse(t, addr.Equal(other))
+	})
+
+	t.Run("should parse and render x-addresses", func(t *testing.T) {
+		addr, err := address.NewXrplAddressFromXAddress(xAddress)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, account, addr.Hex())
+		common.EqualStrings(t, classic, addr.String())
+		common.EqualStrings(t, xAddress, addr.XAddress(false))
+		common.EqualStrings(t, xAddressTestnet, addr.XAddress(true))
+		common.AssertFalse(t, addr.IsTestnet())
+
+		testnetAddr, err := address.NewXrplAddressFromString(xAddressTestnet)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, testnetAddr.IsTestnet())
+		common.AssertTrue(t, testnetAddr.Equal(addr))
+
+		tagged, err := address.NewXrplAddressFromString(xAddressTag1)
+		common.AssertNoError(t, err)
+		tag, hasTag := tagged.Tag()
+		common.AssertTrue(t, hasTag)
+		common.AssertTrue(t, tag == 1)
+		common.EqualStrings(t, xAddressTag1, tagged.String())
+		common.EqualStrings(t, xAddressTag1Testnet, tagged.XAddress(true))
+		common.EqualStrings(t, classic, tagged.ClassicAddress())
+		common.EqualStrings(t, account+"00000001", tagged.Hex())
+		common.AssertTrue(t, 24 == tagged.Length())
+		// the tag is part of the identity of the address
+		common.AssertFalse(t, tagged.Equal(addr))
+
+		taggedTestnet, err := address.NewXrplAddressFromString(xAddressTag1Testnet)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, xAddressTag1Testnet, taggedTestnet.String())
+		common.AssertTrue(t, taggedTestnet.Equal(tagged))
+
+		common.EqualStrings(t, xAddressTag11747, addr.WithTag(11747).String())
+		// tag zero is different from no tag
+		common.AssertFalse(t, addr.WithTag(0).Equal(addr))
+	})
+
+	t.Run("should create address from bytes", func(t *testing.T) {
+		b, _ := hex.DecodeString(account)
+		addr, err := address.NewAddress(b, chainid.EcosystemXrpl)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, classic, addr.String())
+
+		tagged, err := address.NewXrplAddressFromXAddress(xAddressTag11747)
+		common.AssertNoError(t, err)
+		fromBytes, err := address.NewAddress(tagged.Bytes(), chainid.EcosystemXrpl)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, fromBytes.Equal(tagged))
+		common.EqualStrings(t, xAddressTag11747, fromBytes.String())
+		common.EqualStrings(t, "rrrrrrrrrrrrrrrrrrrrrhoLvTp", address.NewZeroAddress(chainid.EcosystemXrpl).String())
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// checksum mismatch
+		_, err := address.NewXrplAddressFromClassic(classic[:len(classic)-1] + "a")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressXrpl)
+		_, err = address.NewXrplAddressFromXAddress(xAddress[:len(xAddress)-1] + "a")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressXrpl)
+		// bitcoin alphabet
+		_, err = address.NewXrplAddressFromClassic("1LdCT3YgvnG9KQqnmq6sQ3T7mBRNuZE2Dy")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressXrpl)
+		// x-address is not a classic address
+		_, err = address.NewXrplAddressFromClassic(xAddress)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressXrpl)
+		// wrong length
+		_, err = address.NewXrplAddress(make([]byte, 21))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressXrpl)
+	})
+}
+
+func TestSS58Address(t *testing.T) {
+	accountId := "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+	generic := "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+	polkadot := "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"
+	kusama := "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"
+	// two bytes prefixes
+	prefix64 := "cEaNSpz4PxFcZ7nT1VEKrKewH67rfx6MfcM6yKojyyPz7qaqp"
+	prefix255 := "yGHXkYLYqxijLKKfd9Q2CB9shRVu8rPNBS53wvwGTutYg4zTg"
+	prefixMax := "yNa8JpqfFB3q8A29rCwSgxvdU94ufJw2yKKxDgznS5m1PoFvn"
+
+	t.Run("should parse addresses with any prefix", func(t *testing.T) {
+		tests := []struct {
+			address string
+			prefix  uint16
+		}{
+			{generic, address.SS58GenericPrefix},
+			{polkadot, address.SS58PolkadotPrefix},
+			{kusama, address.SS58KusamaPrefix},
+			{prefix64, 64},
+			{prefix255, 255},
+			{prefixMax, address.SS58MaxPrefix},
+		}
+		for _, tt := range tests {
+			addr, err := address.NewSS58AddressFromSS58(tt.address)
+			common.AssertNoError(t, err)
+			common.AssertTrue(t, tt.prefix == addr.Prefix())
+			common.EqualStrings(t, accountId, addr.Hex())
+			common.EqualStrings(t, tt.address, addr.String())
+			common.AssertTrue(t, 32 == addr.Length())
+			equalEcosystem(t, chainid.EcosystemSubstrate, addr.Ecosystem())
+		}
+	})
+
+	t.Run("should re-encode for a target prefix", func(t *testing.T) {
+		addr, err := address.NewSS58AddressFromSS58(polkadot)
+		common.AssertNoError(t, err)
+		encoded, err := addr.Encode(address.SS58KusamaPrefix)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, kusama, encoded)
+		encoded, err = addr.Encode(255)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, prefix255, encoded)
+		_, err = addr.Encode(address.SS58MaxPrefix + 1)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSubstrate)
+
+		withPrefix, err := addr.WithPrefix(address.SS58GenericPrefix)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, generic, withPrefix.String())
+		common.EqualStrings(t, polkadot, addr.String())
+		// the prefix is not part of the identity of the address
+		common.AssertTrue(t, withPrefix.Equal(addr))
+	})
+
+	t.Run("should create address from bytes and hex", func(t *testing.T) {
+		b, _ := hex.DecodeString(accountId)
+		addr, err := address.NewAddress(b, chainid.EcosystemSubstrate)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, generic, addr.String())
+
+		fromHex, err := address.NewAddressFromString("0x"+accountId, chainid.EcosystemSubstrate)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, fromHex.Equal(addr))
+		fromString, err := address.NewAddressFromString(kusama, chainid.EcosystemSubstrate)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, fromString.Equal(addr))
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// checksum mismatch
+		_, err := address.NewSS58AddressFromSS58(generic[:len(generic)-1] + "Z")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSubstrate)
+		// shorter address
+		_, err = address.NewSS58AddressFromSS58(generic[:len(generic)-4])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSubstrate)
+		// longer address
+		_, err = address.NewSS58AddressFromSS58(generic + "1111")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSubstrate)
+		// invalid base58 char
+		_, err = address.NewSS58AddressFromSS58(generic[:5] + "0" + generic[6:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSubstrate)
+		// bitcoin address
+		_, err = address.NewSS58AddressFromSS58("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSubstrate)
+		_, err = address.NewSS58Address(make([]byte, 20))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressSubstrate)
+	})
+}
+
+func TestNearAddress(t *testing.T) {
+	implicit := "98793cd91a3f870fb126f66285808c7e094afcfc4eda8a970f6648cdf0dbd6de"
+	ethImplicit := "0xb794f5ea0ba39494ce839613fffba74279579268"
+
+	t.Run("should parse and classify account ids", func(t *testing.T) {
+		tests := []struct {
+			accountId string
+			kind      address.NearAccountKind
+		}{
+			{"lombard.near", address.NearNamedAccount},
+			{"app.lombard.near", address.NearNamedAccount},
+			{"a_b-c.tg", address.NearNamedAccount},
+			{"near", address.NearNamedAccount},
+			{"ab", address.NearNamedAccount},
+			{implicit, address.NearImplicitAccount},
+			{ethImplicit, address.NearEthImplicitAccount},
+			// 64 chars but not hex
+			{strings.Repeat("z", 64), address.NearNamedAccount},
+			// 0x led but not an EVM address
+			{"0xlombard", address.NearNamedAccount},
+		}
+		for _, tt := range tests {
+			addr, err := address.NewNearAddressFromString(tt.accountId)
+			common.AssertNoError(t, err)
+			common.AssertTrue(t, tt.kind == addr.Kind())
+			common.EqualStrings(t, tt.accountId, addr.String())
+			common.EqualStrings(t, tt.accountId, string(addr.Bytes()))
+			common.AssertTrue(t, len(tt.accountId) == addr.Length())
+			equalEcosystem(t, chainid.EcosystemNear, addr.Ecosystem())
+		}
+	})
+
+	t.Run("should expose implicit account keys", func(t *testing.T) {
+		addr, _ := address.NewNearAddressFromString(implicit)
+		publicKey, ok := addr.PublicKey()
+		common.AssertTrue(t, ok)
+		fromKey, err := address.NewNearImplicitAddress(publicKey)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, fromKey.Equal(addr))
+		_, ok = addr.EvmAddress()
+		common.AssertFalse(t, ok)
+
+		ethAddr, _ := address.NewNearAddressFromString(ethImplicit)
+		evm, ok := ethAddr.EvmAddress()
+		common.AssertTrue(t, ok)
+		common.EqualStrings(t, ethImplicit, evm.String())
+		common.AssertTrue(t, address.NewNearEthImplicitAddress(evm).Equal(ethAddr))
+		_, ok = ethAddr.PublicKey()
+		common.AssertFalse(t, ok)
+
+		common.EqualStrings(t, strings.Repeat("0", 64), address.NewZeroAddress(chainid.EcosystemNear).String())
+	})
+
+	t.Run("should create address from bytes", func(t *testing.T) {
+		addr, err := address.NewAddress([]byte("lombard.near"), chainid.EcosystemNear)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "lombard.near", addr.String())
+		fromHex, err := address.NewAddressFromHex(addr.Hex(), chainid.EcosystemNear)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, fromHex.Equal(addr))
+		other, _ := address.NewNearAddressFromString("lombard.testnet")
+		common.AssertFalse(t, addr.Equal(other))
+	})
+
+	t.Run("should reject invalid account ids", func(t *testing.T) {
+		invalid := []string{
+			"",
+			"a",
+			strings.Repeat("a", 65),
+			"Lombard.near",
+			"lombard..near",
+			"lombard.-near",
+			"a__b",
+			".near",
+			"lombard.",
+			"-lombard",
+			"lombard@near",
+			"lombard near",
+			"0xB794F5EA0BA39494CE839613FFFBA74279579268",
+		}
+		for _, accountId := range invalid {
+			_, err := address.NewNearAddressFromString(accountId)
+			common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressNear)
+		}
+		_, err := address.NewNearImplicitAddress(make([]byte, 20))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressNear)
+	})
+}
+
+func TestCardanoAddress(t *testing.T) {
+	// CIP-19 test vectors
+	paymentKeyHash := "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	stakeKeyHash := "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+	scriptHash := "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+	baseKeyKey := "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"
+	baseKeyKeyTestnet := "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae"
+	baseScriptScript := "addr1x8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shskhj42g"
+	pointerKey := "addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k"
+	enterpriseKey := "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"
+	enterpriseScriptTestnet := "addr_test1wrphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcl6szpr"
+	rewardKey := "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"
+	rewardScriptTestnet := "stake_test17rphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcljw6kf"
+	byronIcarus := "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi"
+	byronDaedalus := "DdzFFzCqrhsrcTVhLygT24QwTnNqQqQ8mZrq5jykUzMveU26sxaH529kMpo7VhPrt5pwW3yXU8wUfeD9y2dgEy1hNsCwaSJMfJpMsZmg"
+
+	credentialHash := func(c address.CardanoCredential) string {
+		return hex.EncodeToString(c.Hash[:])
+	}
+
+	t.Run("should parse and render the different types of address", func(t *testing.T) {
+		tests := []struct {
+			address   string
+			typ       address.CardanoAddressType
+			networkId uint8
+		}{
+			{baseKeyKey, address.CardanoBaseKeyKey, 1},
+			{baseKeyKeyTestnet, address.CardanoBaseKeyKey, 0},
+			{baseScriptScript, address.CardanoBaseScriptScript, 1},
+			{pointerKey, address.CardanoPointerKey, 1},
+			{enterpriseKey, address.CardanoEnterpriseKey, 1},
+			{enterpriseScriptTestnet, address.CardanoEnterpriseScript, 0},
+			{rewardKey, address.CardanoRewardKey, 1},
+			{rewardScriptTestnet, address.CardanoRewardScript, 0},
+		}
+		for _, tt := range tests {
+			addr, err := address.NewCardanoAddressFromBech32(tt.address)
+			common.AssertNoError(t, err)
+			common.AssertTrue(t, tt.typ == addr.Type())
+			common.AssertTrue(t, tt.networkId == addr.NetworkId())
+			common.EqualStrings(t, tt.address, addr.String())
+			equalEcosystem(t, chainid.EcosystemCardano, addr.Ecosystem())
+			fromBytes, err := address.NewAddress(addr.Bytes(), chainid.EcosystemCardano)
+			common.AssertNoError(t, err)
+			common.AssertTrue(t, fromBytes.Equal(addr))
+		}
+	})
+
+	t.Run("should expose credentials", func(t *testing.T) {
+		base, _ := address.NewCardanoAddressFromBech32(baseKeyKey)
+		payment, ok := base.PaymentCredential()
+		common.AssertTrue(t, ok)
+		common.AssertFalse(t, payment.IsScript)
+		common.EqualStrings(t, paymentKeyHash, credentialHash(payment))
+		stake, ok := base.StakeCredential()
+		common.AssertTrue(t, ok)
+		common.AssertFalse(t, stake.IsScript)
+		common.EqualStrings(t, stakeKeyHash, credentialHash(stake))
+		common.AssertTrue(t, 57 == base.Length())
+
+		scripts, _ := address.NewCardanoAddressFromBech32(baseScriptScript)
+		payment, _ = scripts.PaymentCredential()
+		common.AssertTrue(t, payment.IsScript)
+		common.EqualStrings(t, scriptHash, credentialHash(payment))
+		stake, _ = scripts.StakeCredential()
+		common.AssertTrue(t, stake.IsScript)
+
+		pointer, _ := address.NewCardanoAddressFromBech32(pointerKey)
+		p, ok := pointer.StakePointer()
+		common.AssertTrue(t, ok)
+		common.AssertTrue(t, p == address.CardanoPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3})
+		_, ok = pointer.StakeCredential()
+		common.AssertFalse(t, ok)
+
+		enterprise, _ := address.NewCardanoAddressFromBech32(enterpriseKey)
+		_, ok = enterprise.StakeCredential()
+		common.AssertFalse(t, ok)
+		_, ok = enterprise.StakePointer()
+		common.AssertFalse(t, ok)
+
+		reward, _ := address.NewCardanoAddressFromBech32(rewardKey)
+		_, ok = reward.PaymentCredential()
+		common.AssertFalse(t, ok)
+		stake, ok = reward.StakeCredential()
+		common.AssertTrue(t, ok)
+		common.EqualStrings(t, stakeKeyHash, credentialHash(stake))
+		common.AssertTrue(t, 29 == reward.Length())
+	})
+
+	t.Run("should check network of the chain", func(t *testing.T) {
+		mainnet, _ := address.NewCardanoAddressFromBech32(baseKeyKey)
+		testnet, _ := address.NewCardanoAddressFromBech32(baseKeyKeyTestnet)
+		common.AssertNoError(t, mainnet.CheckNetwork(chainid.NewCardanoMainnetLChainId()))
+		common.AssertNoError(t, testnet.CheckNetwork(chainid.NewCardanoPreprodLChainId()))
+		common.AssertNoError(t, testnet.CheckNetwork(chainid.NewCardanoPreviewLChainId()))
+		err := mainnet.CheckNetwork(chainid.NewCardanoPreviewLChainId())
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano, address.ErrCardanoNetworkMismatch)
+		err = testnet.CheckNetwork(chainid.NewCardanoMainnetLChainId())
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano, address.ErrCardanoNetworkMismatch)
+		// same credentials on different networks are different addresses
+		common.AssertFalse(t, mainnet.Equal(testnet))
+	})
+
+	t.Run("should reject byron addresses", func(t *testing.T) {
+		_, err := address.NewCardanoAddressFromBech32(byronIcarus)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano, address.ErrCardanoByronAddress)
+		_, err = address.NewAddressFromString(byronDaedalus, chainid.EcosystemCardano)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano, address.ErrCardanoByronAddress)
+		_, err = address.NewCardanoAddress([]byte{0x82, 0xd8, 0x18, 0x58})
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano, address.ErrCardanoByronAddress)
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// checksum mismatch
+		_, err := address.NewCardanoAddressFromBech32(enterpriseKey[:len(enterpriseKey)-1] + "q")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+		// prefix not matching the network id
+		_, err = address.NewCardanoAddressFromBech32("addr_test1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspqnws9")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+		// prefix not matching the type
+		_, err = address.NewCardanoAddressFromBech32("stake1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzersuzp9l4")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+		// bech32m checksum
+		_, err = address.NewCardanoAddressFromBech32("addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers0x8069")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+		// unsupported address type 9
+		_, err = address.NewCardanoAddressFromBech32("addr1jx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerske8ra8")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+		// base address without stake credential
+		hash, _ := hex.DecodeString(paymentKeyHash)
+		_, err = address.NewCardanoAddress(append([]byte{0x01}, hash...))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+		// truncated pointer
+		_, err = address.NewCardanoAddress(append(append([]byte{0x41}, hash...), 0x81))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+		// non canonical pointer
+		_, err = address.NewCardanoAddress(append(append([]byte{0x41}, hash...), 0x80, 0x01, 0x02, 0x03))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+		// unsupported network id
+		_, err = address.NewCardanoAddress(append([]byte{0x62}, hash...))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressCardano)
+	})
+}
+
+func TestAlgorandAddress(t *testing.T) {
+	validBase32 := "2Q2ZHRYV7XJRYYIUDK6QJKM722BCZBKYQVGM3Y42K2COPJLNUJ6QT5ULYA"
+	validHex := "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+
+	t.Run("should parse and render addresses", func(t *testing.T) {
+		addr, err := address.NewAlgorandAddressFromBase32(validBase32)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, validBase32, addr.String())
+		common.EqualStrings(t, validHex, addr.Hex())
+		equalEcosystem(t, chainid.EcosystemAlgorand, addr.Ecosystem())
+		common.AssertTrue(t, address.AlgorandAddressLength == addr.Length())
+
+		fromHex, err := address.NewAddressFromHex(validHex, chainid.EcosystemAlgorand)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, addr.Equal(fromHex))
+		fromString, err := address.NewAddressFromString(validBase32, chainid.EcosystemAlgorand)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, addr.Equal(fromString))
+
+		common.EqualStrings(
+			t,
+			"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ",
+			address.NewZeroAddress(chainid.EcosystemAlgorand).String(),
+		)
+	})
+
+	t.Run("should derive application accounts", func(t *testing.T) {
+		common.EqualStrings(
+			t,
+			"WCS6TVPJRBSARHLN2326LRU5BYVJZUKI2VJ53CAWKYYHDE455ZGKANWMGM",
+			address.NewAlgorandApplicationAddress(1).String(),
+		)
+		common.EqualStrings(
+			t,
+			"JP3ENKDQC2BOYRMLFGKBS7RB2IVNF7VNHCFHVTRNHOENRQ6R4UN7MCNXPI",
+			address.NewAlgorandApplicationAddress(1284326447).String(),
+		)
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// checksum mismatch
+		_, err := address.NewAlgorandAddressFromBase32(validBase32[:len(validBase32)-2] + "AA")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAlgorand)
+		// non canonical trailing bits
+		_, err = address.NewAlgorandAddressFromBase32(validBase32[:len(validBase32)-1] + "B")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAlgorand)
+		// shorter address
+		_, err = address.NewAlgorandAddressFromBase32(validBase32[:len(validBase32)-1])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAlgorand)
+		// lower case
+		_, err = address.NewAlgorandAddressFromBase32(strings.ToLower(validBase32))
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAlgorand)
+		// invalid base32 char
+		_, err = address.NewAlgorandAddressFromBase32(validBase32[:5] + "1" + validBase32[6:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAlgorand)
+		_, err = address.NewAlgorandAddressFromHex(validHex[2:])
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressAlgorand)
+	})
+}
+
+func TestUtxoAddress(t *testing.T) {
+	hash := "751e76e8199196d454941c45d1b3a323f1433bd6"
+	program := "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"
+
+	tests := []struct {
+		name    string
+		address string
+		params  chainid.UtxoNetworkParams
+		typ     address.UtxoAddressType
+		script  string
+	}{
+		{"bitcoin p2pkh", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", chainid.BitcoinMainnetParams, address.UtxoP2PKH, "76a914" + hash + "88ac"},
+		{"bitcoin p2sh", "3CNHUhP3uyB9EUtRLsmvFUmvGdjGdkTxJw", chainid.BitcoinMainnetParams, address.UtxoP2SH, "a914" + hash + "87"},
+		{"bitcoin p2wpkh", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", chainid.BitcoinMainnetParams, address.UtxoP2WPKH, "0014" + hash},
+		{"bitcoin p2wsh", "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", chainid.BitcoinMainnetParams, address.UtxoP2WSH, "0020" + program},
+		{"bitcoin p2tr", "bc1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qj0fj5d", chainid.BitcoinMainnetParams, address.UtxoP2TR, "5120" + program},
+		{"signet p2pkh", "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", chainid.BitcoinSignetParams, address.UtxoP2PKH, "76a914" + hash + "88ac"},
+		{"signet p2sh", "2N3vVYSK5XRgVSGWy21PnsRmBUywSQNdCsf", chainid.BitcoinSignetParams, address.UtxoP2SH, "a914" + hash + "87"},
+		{"signet p2tr", "tb1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q98lawz", chainid.BitcoinSignetParams, address.UtxoP2TR, "5120" + program},
+		{"litecoin p2pkh", "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ", chainid.LitecoinMainnetParams, address.UtxoP2PKH, "76a914" + hash + "88ac"},
+		{"litecoin p2sh", "MJaRnao1s62a2zAKSkmG582KbLKianqb7v", chainid.LitecoinMainnetParams, address.UtxoP2SH, "a914" + hash + "87"},
+		{"litecoin p2wpkh", "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9", chainid.LitecoinMainnetParams, address.UtxoP2WPKH, "0014" + hash},
+		{"litecoin p2wsh", "ltc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qmu8tk5", chainid.LitecoinMainnetParams, address.UtxoP2WSH, "0020" + program},
+		{"litecoin p2tr", "ltc1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q3t8zwg", chainid.LitecoinMainnetParams, address.UtxoP2TR, "5120" + program},
+		{"dogecoin p2pkh", "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE", chainid.DogecoinMainnetParams, address.UtxoP2PKH, "76a914" + hash + "88ac"},
+		{"dogecoin p2sh", "A37YDYSwz3438rFtm1SLVcQHyD7JeueC9H", chainid.DogecoinMainnetParams, address.UtxoP2SH, "a914" + hash + "87"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			addr, err := address.NewUtxoAddressFromString(test.address, test.params)
+			common.AssertNoError(t, err)
+			common.EqualStrings(t, test.address, addr.String())
+			common.EqualStrings(t, test.script, addr.Hex())
+			common.EqualStrings(t, test.typ.String(), addr.Type().String())
+			equalEcosystem(t, chainid.EcosystemBitcoin, addr.Ecosystem())
+			common.AssertTrue(t, len(test.script)/2 == addr.Length())
+
+			fromScript, err := address.NewUtxoAddressFromScript(addr.Bytes(), test.params)
+			common.AssertNoError(t, err)
+			common.EqualStrings(t, test.address, fromScript.String())
+			common.AssertTrue(t, addr.Equal(fromScript))
+
+			// Bitcoin addresses are generic by default and compare equal on the same script
+			generic, err := address.NewAddressFromHex(test.script, chainid.EcosystemBitcoin)
+			common.AssertNoError(t, err)
+			common.AssertTrue(t, addr.Equal(generic))
+			common.AssertTrue(t, generic.Equal(addr))
+		})
+	}
+
+	t.Run("should represent the same address on other networks", func(t *testing.T) {
+		btc, err := address.NewUtxoAddressFromString("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", chainid.BitcoinMainnetParams)
+		common.AssertNoError(t, err)
+		ltc, err := btc.WithParams(chainid.LitecoinMainnetParams)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ", ltc.String())
+		common.AssertTrue(t, btc.Equal(ltc))
+
+		p2tr, err := address.NewUtxoAddressFromString(
+			"bc1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qj0fj5d",
+			chainid.BitcoinMainnetParams,
+		)
+		common.AssertNoError(t, err)
+		_, err = p2tr.WithParams(chainid.DogecoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo, address.ErrUtxoUnsupportedByNetwork)
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		// address of another network
+		_, err := address.NewUtxoAddressFromString("LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ", chainid.BitcoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		_, err = address.NewUtxoAddressFromString("ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9", chainid.BitcoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		_, err = address.NewUtxoAddressFromString("tb1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q98lawz", chainid.BitcoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		// bad checksum
+		_, err = address.NewUtxoAddressFromString("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ", chainid.BitcoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		_, err = address.NewUtxoAddressFromString("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", chainid.BitcoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		// witness v0 encoded with bech32m
+		_, err = address.NewUtxoAddressFromString("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh", chainid.BitcoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		// witness v1 encoded with bech32
+		_, err = address.NewUtxoAddressFromString(
+			"bc1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q8ne730",
+			chainid.BitcoinMainnetParams,
+		)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		// non standard script
+		_, err = address.NewUtxoAddressFromScript([]byte{0x6a, 0x01, 0x00}, chainid.BitcoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		// segwit script on a network without segwit
+		script, err := hex.DecodeString("0014" + hash)
+		common.AssertNoError(t, err)
+		_, err = address.NewUtxoAddressFromScript(script, chainid.DogecoinMainnetParams)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo, address.ErrUtxoUnsupportedByNetwork)
+	})
+}
+
+func TestRegisterCodec(t *testing.T) {
+	ecosystem := chainid.Ecosystem(150)
+
+	// unregistered ecosystems fall back to GenericAddress
+	addr, err := address.NewAddressFromString("0x0102", ecosystem)
+	common.AssertNoError(t, err)
+	_, ok := addr.(*address.GenericAddress)
+	common.AssertTrue(t, ok)
+
+	// the codec of a downstream ecosystem whose addresses are EVM-like
+	err = address.RegisterCodec(ecosystem, address.Codec{
+		FromBytes: func(b []byte) (address.Address, error) {
+			if len(b) != 2 {
+				return nil, address.ErrBadAddress
+			}
+			return address.NewGenericAddress(b, ecosystem)
+		},
+		FromString: func(s string) (address.Address, error) {
+			return address.NewGenericAddressFromHex(strings.TrimPrefix(s, "downstream:"), ecosystem)
+		},
+		Zero: func() address.Address {
+			addr, _ := address.NewGenericAddress([]byte{0, 0}, ecosystem)
+			return addr
+		},
+	})
+	common.AssertNoError(t, err)
+
+	_, err = address.NewAddress([]byte{1, 2, 3}, ecosystem)
+	common.AssertError(t, err, address.ErrBadAddress)
+	addr, err = address.NewAddressFromString("downstream:0102", ecosystem)
+	common.AssertNoError(t, err)
+	common.EqualStrings(t, "0x0102", addr.String())
+	common.EqualStrings(t, "0x0000", address.NewZeroAddress(ecosystem).String())
+	_, ok = address.LookupCodec(ecosystem)
+	common.AssertTrue(t, ok)
+
+	// duplicate and built-in codecs
+	err = address.RegisterCodec(ecosystem, address.Codec{FromBytes: func(b []byte) (address.Address, error) {
+		return address.NewGenericAddress(b, ecosystem)
+	}})
+	common.AssertError(t, err, address.ErrCodecAlreadyRegistered)
+	err = address.RegisterCodec(chainid.EcosystemEVM, address.Codec{FromBytes: func(b []byte) (address.Address, error) {
+		return address.NewEvmAddress(b)
+	}})
+	common.AssertError(t, err, address.ErrCodecAlreadyRegistered)
+	// invalid codec
+	err = address.RegisterCodec(chainid.Ecosystem(151), address.Codec{})
+	common.AssertError(t, err, address.ErrInvalidCodec)
+
+	// errors of built-in codecs are returned along with a nil Address
+	addr, err = address.NewAddress([]byte{1, 2, 3}, chainid.EcosystemEVM)
+	common.AssertError(t, err, address.ErrBadAddress)
+	common.AssertTrue(t, addr == nil)
+}
+
+func TestParseAddressForChain(t *testing.T) {
+	t.Run("should enforce utxo network params", func(t *testing.T) {
+		addr, err := address.ParseAddressForChain(chainid.NewBitcoinLChainId(), "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "0014751e76e8199196d454941c45d1b3a323f1433bd6", addr.Hex())
+		common.AssertNoError(t, address.ValidateAddressForChain(chainid.NewBitcoinLChainId(), addr))
+
+		addr, err = address.ParseAddressForChain(chainid.NewLitecoinLChainId(), "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ")
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ", addr.String())
+		// same script represented for another network
+		err = address.ValidateAddressForChain(chainid.NewBitcoinLChainId(), addr)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+
+		// signet address on mainnet
+		_, err = address.ParseAddressForChain(
+			chainid.NewBitcoinLChainId(),
+			"tb1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q98lawz",
+		)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+		_, err = address.ParseAddressForChain(chainid.NewBitcoinLChainId(), "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+		// dogecoin address on litecoin
+		_, err = address.ParseAddressForChain(chainid.NewLitecoinLChainId(), "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+		// malformed address
+		_, err = address.ParseAddressForChain(chainid.NewBitcoinLChainId(), "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadAddressUtxo)
+		common.AssertFalse(t, errors.Is(err, address.ErrChainMismatch))
+
+		// generic addresses carry the output script
+		generic, err := address.NewAddressFromHex(
+			"51201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+			chainid.EcosystemBitcoin,
+		)
+		common.AssertNoError(t, err)
+		common.AssertNoError(t, address.ValidateAddressForChain(chainid.NewBitcoinLChainId(), generic))
+		err = address.ValidateAddressForChain(chainid.NewDogecoinLChainId(), generic)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrAddressKindNotAllowed)
+	})
+
+	t.Run("should enforce cosmos bech32 prefix", func(t *testing.T) {
+		addr, err := address.ParseAddressForChain(chainid.NewOsmosisLChainId(), "osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2")
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "751e76e8199196d454941c45d1b3a323f1433bd6", addr.Hex())
+		_, err = address.ParseAddressForChain(chainid.NewOsmosisLChainId(), "cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrPrefixMismatch)
+		// hex is still accepted
+		hexAddr, err := address.ParseAddressForChain(chainid.NewOsmosisLChainId(), "0x751e76e8199196d454941c45d1b3a323f1433bd6")
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, addr.Equal(hexAddr))
+	})
+
+	t.Run("should enforce ss58 prefix", func(t *testing.T) {
+		polkadot := "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"
+		addr, err := address.ParseAddressForChain(chainid.NewPolkadotLChainId(), polkadot)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, polkadot, addr.String())
+		// generic prefix is accepted and converted to the chain one
+		addr, err = address.ParseAddressForChain(chainid.NewPolkadotLChainId(), "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY")
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, polkadot, addr.String())
+
+		_, err = address.ParseAddressForChain(chainid.NewPolkadotLChainId(), "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrPrefixMismatch)
+		kusama, err := address.NewSS58AddressFromSS58("HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F")
+		common.AssertNoError(t, err)
+		err = address.ValidateAddressForChain(chainid.NewPolkadotLChainId(), kusama)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrPrefixMismatch)
+		common.AssertNoError(t, address.ValidateAddressForChain(chainid.NewKusamaLChainId(), kusama))
+	})
+
+	t.Run("should enforce network flags", func(t *testing.T) {
+		// TON testnet only address
+		_, err := address.ParseAddressForChain(chainid.NewTonMainnetLChainId(), "kQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74pzE8")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+		_, err = address.ParseAddressForChain(chainid.NewTonTestnetLChainId(), "kQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74pzE8")
+		common.AssertNoError(t, err)
+		_, err = address.ParseAddressForChain(chainid.NewTonMainnetLChainId(), "EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2")
+		common.AssertNoError(t, err)
+
+		// XRPL X-addresses
+		_, err = address.ParseAddressForChain(chainid.NewXrplMainnetLChainId(), "T719a5UwUCnEs54UsxG9CJYYDhwmFCvbJNZbi37gBGkRkbE")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+		_, err = address.ParseAddressForChain(chainid.NewXrplTestnetLChainId(), "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+		_, err = address.ParseAddressForChain(chainid.NewXrplTestnetLChainId(), "T719a5UwUCnEs54UsxG9CJYYDhwmFCvbJNZbi37gBGkRkbE")
+		common.AssertNoError(t, err)
+		classic, err := address.ParseAddressForChain(chainid.NewXrplTestnetLChainId(), "rrrrrrrrrrrrrrrrrrrrrhoLvTp")
+		common.AssertNoError(t, err)
+		common.AssertNoError(t, address.ValidateAddressForChain(chainid.NewXrplMainnetLChainId(), classic))
+
+		// Cardano network id
+		_, err = address.ParseAddressForChain(
+			chainid.NewCardanoMainnetLChainId(),
+			"addr_test1wrphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcl6szpr",
+		)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrNetworkMismatch, address.ErrCardanoNetworkMismatch)
+		_, err = address.ParseAddressForChain(
+			chainid.NewCardanoPreprodLChainId(),
+			"addr_test1wrphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcl6szpr",
+		)
+		common.AssertNoError(t, err)
+
+		// NEAR top-level accounts
+		_, err = address.ParseAddressForChain(chainid.NewNearMainnetLChainId(), "lombard.testnet")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+		_, err = address.ParseAddressForChain(chainid.NewNearTestnetLChainId(), "lombard.near")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrNetworkMismatch)
+		_, err = address.ParseAddressForChain(chainid.NewNearMainnetLChainId(), "lombard.near")
+		common.AssertNoError(t, err)
+	})
+
+	t.Run("should enforce the ecosystem", func(t *testing.T) {
+		evm, err := address.NewEvmAddressFromHex("0x8236a87084f8B84306f72007F36F2618A5634494")
+		common.AssertNoError(t, err)
+		common.AssertNoError(t, address.ValidateAddressForChain(chainid.NewEVMEthereumLChainId(), evm))
+		err = address.ValidateAddressForChain(chainid.NewSolanaMainnetLChainId(), evm)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrChainMismatch, address.ErrEcosystemMismatch)
+		err = address.ValidateAddressForChain(chainid.NewEVMEthereumLChainId(), nil)
+		common.AssertError(t, err, address.ErrEmptyAddress)
+
+		// chains with unknown rules are treated as their ecosystem
+		addr, err := address.ParseAddressForChain(chainid.NewEVMSepoliaLChainId(), "0x8236a87084f8B84306f72007F36F2618A5634494")
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, evm.Equal(addr))
+	})
+}
+
+func TestDetectCandidates(t *testing.T) {
+	type expected struct {
+		ecosystem  chainid.Ecosystem
+		confidence address.Confidence
+		network    string
+	}
+	tests := []struct {
+		name       string
+		address    string
+		candidates []expected
+	}{
+		{
+			"evm",
+			"0x8236a87084f8B84306f72007F36F2618A5634494",
+			[]expected{
+				{chainid.EcosystemEVM, address.ConfidenceHigh, ""},
+				{chainid.EcosystemCosmos, address.ConfidenceLow, ""},
+				{chainid.EcosystemTron, address.ConfidenceLow, ""},
+			},
+		},
+		{
+			"64 hex",
+			"0xbfde966bacd4260852155f7b523ef157f0b75a0e1e8a0784e463c3ef0bb69deb",
+			[]expected{
+				{chainid.EcosystemSui, address.ConfidenceMedium, ""},
+				{chainid.EcosystemCosmos, address.ConfidenceMedium, ""},
+				{chainid.EcosystemStarknet, address.ConfidenceMedium, ""},
+				{chainid.EcosystemAptos, address.ConfidenceMedium, ""},
+				{chainid.EcosystemSubstrate, address.ConfidenceLow, ""},
+			},
+		},
+		{
+			"solana",
+			"4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T",
+			[]expected{{chainid.EcosystemSolana, address.ConfidenceMedium, ""}},
+		},
+		{
+			"cosmos known prefix",
+			"osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2",
+			[]expected{
+				{chainid.EcosystemCosmos, address.ConfidenceHigh, "osmosis"},
+				{chainid.EcosystemNear, address.ConfidenceLow, ""},
+			},
+		},
+		{
+			"cosmos prefix shared by several chains",
+			"lom1w508d6qejxtdg4y5r3zarvary0c5xw7kwfxev3",
+			[]expected{
+				{chainid.EcosystemCosmos, address.ConfidenceHigh, ""},
+				{chainid.EcosystemNear, address.ConfidenceLow, ""},
+			},
+		},
+		{
+			"cosmos unknown prefix",
+			"foo1w508d6qejxtdg4y5r3zarvary0c5xw7kgk9lk3",
+			[]expected{
+				{chainid.EcosystemCosmos, address.ConfidenceMedium, ""},
+				{chainid.EcosystemNear, address.ConfidenceLow, ""},
+			},
+		},
+		{
+			"bitcoin segwit",
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			[]expected{
+				{chainid.EcosystemBitcoin, address.ConfidenceHigh, "bitcoin"},
+				{chainid.EcosystemNear, address.ConfidenceLow, ""},
+			},
+		},
+		{
+			"litecoin",
+			"LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ",
+			[]expected{{chainid.EcosystemBitcoin, address.ConfidenceHigh, "litecoin"}},
+		},
+		{
+			"substrate",
+			"15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5",
+			[]expected{{chainid.EcosystemSubstrate, address.ConfidenceHigh, ""}},
+		},
+		{
+			"near",
+			"lombard.near",
+			[]expected{{chainid.EcosystemNear, address.ConfidenceHigh, ""}},
+		},
+		{
+			"tron",
+			"TMqiKhVwChi6FZbNxUpNeF5UZsfabPSfzY",
+			[]expected{{chainid.EcosystemTron, address.ConfidenceHigh, ""}},
+		},
+		{
+			"xrpl",
+			"rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			[]expected{{chainid.EcosystemXrpl, address.ConfidenceHigh, ""}},
+		},
+		{
+			"ton",
+			"EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2",
+			[]expected{{chainid.EcosystemTon, address.ConfidenceHigh, ""}},
+		},
+		{
+			"algorand",
+			"2Q2ZHRYV7XJRYYIUDK6QJKM722BCZBKYQVGM3Y42K2COPJLNUJ6QT5ULYA",
+			[]expected{{chainid.EcosystemAlgorand, address.ConfidenceHigh, ""}},
+		},
+		{
+			"cardano",
+			"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+			[]expected{
+				{chainid.EcosystemCardano, address.ConfidenceHigh, ""},
+				{chainid.EcosystemNear, address.ConfidenceLow, ""},
+			},
+		},
+		{"empty", "  ", nil},
+		{"invalid", "Not an address!", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// skip codecs registered by other tests for ecosystems unknown to chainid
+			var candidates []address.Candidate
+			for _, c := range address.DetectCandidates(test.address) {
+				if c.Ecosystem.IsSupported() {
+					candidates = append(candidates, c)
+				}
+			}
+			if len(candidates) != len(test.candidates) {
+				t.Fatalf("expected %d candidates, got %d: %v", len(test.candidates), len(candidates), candidates)
+			}
+			for i, c := range candidates {
+				equalEcosystem(t, test.candidates[i].ecosystem, c.Ecosystem)
+				common.EqualStrings(t, test.candidates[i].confidence.String(), c.Confidence.String())
+				common.EqualStrings(t, test.candidates[i].network, c.Network)
+				// detection agrees with parsing
+				if c.Network == "" && c.Ecosystem != chainid.EcosystemCosmos {
+					parsed, err := address.NewAddressFromString(test.address, c.Ecosystem)
+					common.AssertNoError(t, err)
+					common.AssertTrue(t, parsed.Equal(c.Address))
+				}
+			}
+		})
+	}
+}
+
+func TestBytes32(t *testing.T) {
+	padded := func(s string) string {
+		return strings.Repeat("0", 2*address.Bytes32Length-len(s)) + s
+	}
+	parse := func(t *testing.T, s string, e chainid.Ecosystem) address.Address {
+		t.Helper()
+		a, err := address.NewAddressFromString(s, e)
+		common.AssertNoError(t, err)
+		return a
+	}
+	fromHex := func(t *testing.T, s string) [address.Bytes32Length]byte {
+		t.Helper()
+		b, err := hex.DecodeString(s)
+		common.AssertNoError(t, err)
+		return [address.Bytes32Length]byte(b)
+	}
+
+	t.Run("should round trip addresses of every ecosystem", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			address   string
+			ecosystem chainid.Ecosystem
+			bytes32   string
+		}{
+			{"evm", "0x8236a87084f8b84306f72007f36f2618a5634494", chainid.EcosystemEVM, padded("8236a87084f8b84306f72007f36f2618a5634494")},
+			{"sui", "0xbfde966bacd4260852155f7b523ef157f0b75a0e1e8a0784e463c3ef0bb69deb", chainid.EcosystemSui, "bfde966bacd4260852155f7b523ef157f0b75a0e1e8a0784e463c3ef0bb69deb"},
+			{"solana", "14grJpemFaf88c8tiVb77W7TYg2W3ir6pfkKz3YjhhZ5", chainid.EcosystemSolana, ""},
+			{"cosmos sdk", "4AF2A0E44F9CD6F5E2FD5F0C06BC230AF3EF688C", chainid.EcosystemCosmos, padded("4af2a0e44f9cd6f5e2fd5f0c06bc230af3ef688c")},
+			{"cosmwasm", "1A9568EC8F8E3F6740E1BCAE9C6233256812C4B775AEF4BE8E913EAF76243E1D", chainid.EcosystemCosmos, "1a9568ec8f8e3f6740e1bcae9c6233256812c4b775aef4be8e913eaf76243e1d"},
+			{"starknet", "0x3e8e9423d80e1774a7ca128fccd8bf5f1f7753be658c5e645929037f7c819040", chainid.EcosystemStarknet, "3e8e9423d80e1774a7ca128fccd8bf5f1f7753be658c5e645929037f7c819040"},
+			{"aptos", "0x1", chainid.EcosystemAptos, padded("01")},
+			{"ton", "EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2", chainid.EcosystemTon, "ed1691307050047117b998b561d8de82d31fbf84910ced6eb5fc92e7485ef8a7"},
+			{"tron", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", chainid.EcosystemTron, padded("a614f803b6fd780986a42c78ec9c7f77e6ded13c")},
+			{"stellar", "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ", chainid.EcosystemStellar, "3f0c34bf93ad0d9971d04ccc90f705511c838aad9734a4a2fb0d7a03fc7fe89a"},
+			{"xrpl classic", "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", chainid.EcosystemXrpl, padded("5e7b112523f68d2f5e879db4eac51c6698a69304")},
+			{"xrpl tagged", "X7AcgcsBL6XDcUb289X4mJ8djcdyKaLFuhLRuNXPrDeJd9A", chainid.EcosystemXrpl, padded("0100002de35e7b112523f68d2f5e879db4eac51c6698a69304")},
+			{"xrpl tagged 1", "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu", chainid.EcosystemXrpl, padded("01000000015e7b112523f68d2f5e879db4eac51c6698a69304")},
+			{"substrate", "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", chainid.EcosystemSubstrate, "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"},
+			{"near implicit", "98793cd91a3f870fb126f66285808c7e094afcfc4eda8a970f6648cdf0dbd6de", chainid.EcosystemNear, "98793cd91a3f870fb126f66285808c7e094afcfc4eda8a970f6648cdf0dbd6de"},
+			{"near implicit zero prefixed", "0000616161616161616161616161616161616161616161616161616161616161", chainid.EcosystemNear, "0000616161616161616161616161616161616161616161616161616161616161"},
+			{"cardano enterprise", "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", chainid.EcosystemCardano, padded("619493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e")},
+			{"cardano reward", "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw", chainid.EcosystemCardano, padded("e1337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251")},
+			{"algorand", "2Q2ZHRYV7XJRYYIUDK6QJKM722BCZBKYQVGM3Y42K2COPJLNUJ6QT5ULYA", chainid.EcosystemAlgorand, "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"},
+			{"bitcoin p2pkh", "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac", chainid.EcosystemBitcoin, padded("76a914751e76e8199196d454941c45d1b3a323f1433bd688ac")},
+			{"bitcoin p2sh", "a914751e76e8199196d454941c45d1b3a323f1433bd687", chainid.EcosystemBitcoin, padded("a914751e76e8199196d454941c45d1b3a323f1433bd687")},
+			{"bitcoin p2wpkh", "0014751e76e8199196d454941c45d1b3a323f1433bd6", chainid.EcosystemBitcoin, padded("0014751e76e8199196d454941c45d1b3a323f1433bd6")},
+			{"generic", "0xbfde966bacd4260852155f7b523ef157f0b75a0e1e8a0784e463c3ef0bb69deb", chainid.Ecosystem(200), "bfde966bacd4260852155f7b523ef157f0b75a0e1e8a0784e463c3ef0bb69deb"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				a := parse(t, tt.address, tt.ecosystem)
+				b, err := a.Bytes32()
+				common.AssertNoError(t, err)
+				if tt.bytes32 != "" {
+					common.EqualStrings(t, tt.bytes32, hex.EncodeToString(b[:]))
+				}
+				decoded, err := address.NewAddressFromBytes32(b, tt.ecosystem)
+				common.AssertNoError(t, err)
+				common.AssertTrue(t, a.Equal(decoded))
+				common.EqualStrings(t, a.String(), decoded.String())
+				decodedBytes32, err := decoded.Bytes32()
+				common.AssertNoError(t, err)
+				common.AssertTrue(t, b == decodedBytes32)
+			})
+		}
+	})
+
+	t.Run("should round trip utxo addresses as generic scripts", func(t *testing.T) {
+		utxo, err := address.NewUtxoAddressFromString("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", chainid.BitcoinMainnetParams)
+		common.AssertNoError(t, err)
+		b, err := utxo.Bytes32()
+		common.AssertNoError(t, err)
+		decoded, err := address.NewAddressFromBytes32(b, chainid.EcosystemBitcoin)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, utxo.Equal(decoded))
+		common.EqualBytes(t, utxo.Bytes(), decoded.Bytes())
+	})
+
+	t.Run("should encode equal xrpl addresses regardless of the network flag", func(t *testing.T) {
+		mainnet := parse(t, "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu", chainid.EcosystemXrpl)
+		testnet := parse(t, "T719a5UwUCnEs54UsxG9CJYYDhwmFCvbJNZbi37gBGkRkbE", chainid.EcosystemXrpl)
+		common.AssertTrue(t, mainnet.Equal(testnet))
+		mainnetBytes32, err := mainnet.Bytes32()
+		common.AssertNoError(t, err)
+		testnetBytes32, err := testnet.Bytes32()
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, mainnetBytes32 == testnetBytes32)
+		decoded, err := address.NewAddressFromBytes32(testnetBytes32, chainid.EcosystemXrpl)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, testnet.Equal(decoded))
+		common.EqualStrings(t, mainnet.String(), decoded.String())
+	})
+
+	t.Run("should round trip stellar contracts through their constructor", func(t *testing.T) {
+		contract := parse(t, "CA3D5KRYM6CB7OWQ6TWYRR3Z4T7GNZLKERYNZGGA5SOAOPIFY6YQGAXE", chainid.EcosystemStellar)
+		b, err := contract.Bytes32()
+		common.AssertNoError(t, err)
+		decoded, err := address.NewStellarContractAddress(b[:])
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, contract.Equal(decoded))
+		common.EqualStrings(t, contract.String(), decoded.String())
+	})
+
+	t.Run("should reject addresses that are not representable", func(t *testing.T) {
+		p2wsh, err := address.NewUtxoAddressFromString(
+			"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", chainid.BitcoinMainnetParams,
+		)
+		common.AssertNoError(t, err)
+		tests := []struct {
+			name    string
+			address address.Address
+		}{
+			{"ton masterchain", parse(t, "-1:3333333333333333333333333333333333333333333333333333333333333333", chainid.EcosystemTon)},
+			{"stellar muxed", parse(t, "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLK", chainid.EcosystemStellar)},
+			{"near named", parse(t, "alice.near", chainid.EcosystemNear)},
+			{"near long named", parse(t, "a-very-long-account-name-for-test.near", chainid.EcosystemNear)},
+			{"near eth-implicit", parse(t, "0x8236a87084f8b84306f72007f36f2618a5634494", chainid.EcosystemNear)},
+			{"cardano base", parse(t, "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x", chainid.EcosystemCardano)},
+			{"bitcoin p2wsh", p2wsh},
+			{"bitcoin p2tr", parse(t, "51201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262", chainid.EcosystemBitcoin)},
+			{"generic shorter", parse(t, "0x8236a87084f8b84306f72007f36f2618a5634494", chainid.Ecosystem(200))},
+			{"generic longer", parse(t, "0x3e8e9423d80e1774a7ca128fccd8bf5f1f7753be658c5e645929037f7c819040889955ef", chainid.Ecosystem(200))},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.address.Bytes32()
+				common.AssertError(t, err, address.ErrNotRepresentableBytes32, address.ErrBadAddress)
+			})
+		}
+	})
+
+	t.Run("should reject bytes32 that do not represent an address", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			bytes32   string
+			ecosystem chainid.Ecosystem
+			errs      []error
+		}{
+			{"evm padding", "01" + padded("01")[2:], chainid.EcosystemEVM, []error{address.ErrBadPadding}},
+			{"tron padding", "01" + padded("01")[2:], chainid.EcosystemTron, []error{address.ErrBadPadding}},
+			{"xrpl padding", "01" + padded("01")[2:], chainid.EcosystemXrpl, []error{address.ErrBadPadding}},
+			{"xrpl flags", padded("0200000000" + strings.Repeat("11", 20)), chainid.EcosystemXrpl, []error{address.ErrBadAddressXrpl}},
+			{"xrpl tag without flag", padded("0000000001" + strings.Repeat("11", 20)), chainid.EcosystemXrpl, []error{address.ErrBadAddressXrpl}},
+			// padded cardano address whose header is zero would be decoded as a shorter address
+			{"cardano zero header", padded("009493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"), chainid.EcosystemCardano, nil},
+			{"bitcoin witness program", strings.Repeat("33", address.Bytes32Length), chainid.EcosystemBitcoin, []error{address.ErrNotRepresentableBytes32}},
+			{"bitcoin non standard script", padded("0014751e76e8199196d454941c45d1b3a323f1433b"), chainid.EcosystemBitcoin, []error{address.ErrNotRepresentableBytes32}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := address.NewAddressFromBytes32(fromHex(t, tt.bytes32), tt.ecosystem)
+				common.AssertError(t, err, append(tt.errs, address.ErrBadAddress)...)
+			})
+		}
+	})
+}
+
+func TestChainAddress(t *testing.T) {
+	ethereum := chainid.NewEVMEthereumLChainId()
+	evm, err := address.NewEvmAddressFromHex("0x8236a87084f8B84306f72007F36F2618A5634494")
+	common.AssertNoError(t, err)
+
+	t.Run("should bind addresses to chains of the same ecosystem", func(t *testing.T) {
+		c, err := address.NewChainAddress(ethereum, evm)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, ethereum.Equal(c.Chain()))
+		common.AssertTrue(t, evm.Equal(c.Address()))
+		common.AssertFalse(t, c.IsZero())
+		common.EqualStrings(t, ethereum.String()+":0x8236a87084f8b84306f72007f36f2618a5634494", c.String())
+
+		_, err = address.NewChainAddress(chainid.NewSuiMainnetLChainId(), evm)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrEcosystemMismatch)
+		_, err = address.NewChainAddress(ethereum, nil)
+		common.AssertError(t, err, address.ErrEmptyAddress)
+		_, err = address.NewChainAddress(nil, evm)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadChainAddress)
+		// chain rules are enforced as well
+		testnet, err := address.NewXrplAddressFromString("T719a5UwUCnEs54UsxG9CJYYDhwmFCqkr7wxCcNcfZ6p5GZ")
+		common.AssertNoError(t, err)
+		_, err = address.NewChainAddress(chainid.NewXrplMainnetLChainId(), testnet)
+		common.AssertError(t, err, address.ErrNetworkMismatch)
+	})
+
+	t.Run("should compare chain and address", func(t *testing.T) {
+		c, err := address.NewChainAddress(ethereum, evm)
+		common.AssertNoError(t, err)
+		same, err := address.NewChainAddress(chainid.NewEVMEthereumLChainId(), address.NewZeroAddress(chainid.EcosystemEVM))
+		common.AssertNoError(t, err)
+		common.AssertFalse(t, c.Equal(same))
+		same, err = address.NewChainAddress(chainid.NewEVMEthereumLChainId(), evm)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, c.Equal(same))
+		otherChain, err := address.NewChainAddress(chainid.NewEVMBaseLChainId(), evm)
+		common.AssertNoError(t, err)
+		common.AssertFalse(t, c.Equal(otherChain))
+		common.AssertFalse(t, c.Equal(address.ChainAddress{}))
+		common.AssertTrue(t, address.ChainAddress{}.Equal(address.ChainAddress{}))
+	})
+
+	t.Run("should round trip the string form", func(t *testing.T) {
+		bitcoinScript, err := address.NewAddressFromHex("0014751e76e8199196d454941c45d1b3a323f1433bd6", chainid.EcosystemBitcoin)
+		common.AssertNoError(t, err)
+		ton, err := address.NewTonAddressFromString("-1:3333333333333333333333333333333333333333333333333333333333333333")
+		common.AssertNoError(t, err)
+		cosmos, err := address.NewCosmosAddressFromBech32("osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2", "osmo")
+		common.AssertNoError(t, err)
+		tests := []struct {
+			name    string
+			chain   chainid.LChainId
+			address address.Address
+		}{
+			{"evm", ethereum, evm},
+			{"bitcoin", chainid.NewBitcoinLChainId(), bitcoinScript},
+			{"ton", chainid.NewTonMainnetLChainId(), ton},
+			{"cosmos", chainid.NewOsmosisLChainId(), cosmos},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c, err := address.NewChainAddress(tt.chain, tt.address)
+				common.AssertNoError(t, err)
+				parsed, err := address.ParseChainAddress(c.String())
+				common.AssertNoError(t, err)
+				common.AssertTrue(t, c.Equal(parsed))
+				common.AssertTrue(t, tt.address.Equal(parsed.Address()))
+			})
+		}
+
+		// addresses of known utxo networks are represented for the network
+		c, err := address.NewChainAddress(chainid.NewBitcoinLChainId(), bitcoinScript)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, chainid.NewBitcoinLChainId().String()+":bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", c.String())
+	})
+
+	t.Run("should parse the chain id notation", func(t *testing.T) {
+		c, err := address.ParseChainAddress("evm:1:0x8236a87084f8B84306f72007F36F2618A5634494")
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, c.Chain().Equal(ethereum))
+		common.AssertTrue(t, c.Address().Equal(evm))
+
+		c, err = address.ParseChainAddress("ton:-239:0:ed1691307050047117b998b561d8de82d31fbf84910ced6eb5fc92e7485ef8a7")
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, c.Chain().Equal(chainid.NewTonMainnetLChainId()))
+		common.EqualStrings(t, "EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2", c.Address().String())
+
+		_, err = address.ParseChainAddress("evm:1")
+		common.AssertError(t, err, address.ErrBadChainAddress)
+		_, err = address.ParseChainAddress("evm:x:0x8236a87084f8B84306f72007F36F2618A5634494")
+		common.AssertError(t, err, address.ErrBadChainAddress, chainid.ErrInvalidNotation)
+	})
+
+	t.Run("should reject malformed strings", func(t *testing.T) {
+		_, err := address.ParseChainAddress(ethereum.String())
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadChainAddress)
+		_, err = address.ParseChainAddress("0x01:0x8236a87084f8b84306f72007f36f2618a5634494")
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadChainAddress)
+		_, err = address.ParseChainAddress(ethereum.String() + ":alice.near")
+		common.AssertError(t, err, address.ErrBadAddress)
+		_, err = address.ParseChainAddress(chainid.NewLitecoinLChainId().String() + ":bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+		common.AssertError(t, err, address.ErrNetworkMismatch)
+	})
+
+	t.Run("should marshal json", func(t *testing.T) {
+		c, err := address.NewChainAddress(ethereum, evm)
+		common.AssertNoError(t, err)
+		type payload struct {
+			Recipient address.ChainAddress            `json:"recipient"`
+			Empty     address.ChainAddress            `json:"empty"`
+			Limits    map[address.ChainAddress]uint64 `json:"limits"`
+		}
+		encoded, err := json.Marshal(payload{Recipient: c, Limits: map[address.ChainAddress]uint64{c: 10}})
+		common.AssertNoError(t, err)
+		expected := fmt.Sprintf(`{"recipient":"%[1]s","empty":"","limits":{"%[1]s":10}}`, c.String())
+		common.EqualStrings(t, expected, string(encoded))
+
+		var decoded payload
+		common.AssertNoError(t, json.Unmarshal(encoded, &decoded))
+		common.AssertTrue(t, c.Equal(decoded.Recipient))
+		common.AssertTrue(t, decoded.Empty.IsZero())
+
+		err = json.Unmarshal([]byte(`{"recipient":"0x00:0x00"}`), &decoded)
+		common.AssertError(t, err, address.ErrBadChainAddress)
+	})
+}
+
+func TestAddressKey(t *testing.T) {
+	parse := func(t *testing.T, s string, e chainid.Ecosystem) address.Address {
+		t.Helper()
+		a, err := address.NewAddressFromString(s, e)
+		common.AssertNoError(t, err)
+		return a
+	}
+
+	t.Run("should give the same key to equal addresses", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			ecosystem chainid.Ecosystem
+			a1        string
+			a2        string
+		}{
+			{"evm checksum", chainid.EcosystemEVM, "0x8236a87084f8B84306f72007F36F2618A5634494", "8236a87084f8b84306f72007f36f2618a5634494"},
+			{"ton bounceable flag", chainid.EcosystemTon, "EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2", "UQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p9dz"},
+			{"ton raw", chainid.EcosystemTon, "EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2", "0:ed1691307050047117b998b561d8de82d31fbf84910ced6eb5fc92e7485ef8a7"},
+			{"xrpl x-address", chainid.EcosystemXrpl, "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", "X7AcgcsBL6XDcUb289X4mJ8djcdyKaB5hJDWMArnXr61cqZ"},
+			{"ss58 prefix", chainid.EcosystemSubstrate, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"},
+			{"tron hex", chainid.EcosystemTron, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", "41a614f803b6fd780986a42c78ec9c7f77e6ded13c"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				a1, a2 := parse(t, tt.a1, tt.ecosystem), parse(t, tt.a2, tt.ecosystem)
+				common.AssertTrue(t, a1.Equal(a2))
+				common.AssertTrue(t, a1.Key() == a2.Key())
+				equalEcosystem(t, tt.ecosystem, a1.Key().Ecosystem())
+				common.AssertTrue(t, a1.Length() == a1.Key().Length())
+			})
+		}
+	})
+
+	t.Run("should give different keys to different addresses", func(t *testing.T) {
+		hash := "bfde966bacd4260852155f7b523ef157f0b75a0e1e8a0784e463c3ef0bb69deb"
+		sui := parse(t, hash, chainid.EcosystemSui)
+		starknet := parse(t, hash, chainid.EcosystemStarknet)
+		common.AssertFalse(t, sui.Key() == starknet.Key())
+
+		untagged := parse(t, "X7AcgcsBL6XDcUb289X4mJ8djcdyKaB5hJDWMArnXr61cqZ", chainid.EcosystemXrpl)
+		tagged := parse(t, "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu", chainid.EcosystemXrpl)
+		common.AssertFalse(t, untagged.Equal(tagged))
+		common.AssertFalse(t, untagged.Key() == tagged.Key())
+
+		elector := parse(t, "-1:3333333333333333333333333333333333333333333333333333333333333333", chainid.EcosystemTon)
+		basechain := parse(t, "0:3333333333333333333333333333333333333333333333333333333333333333", chainid.EcosystemTon)
+		common.AssertFalse(t, elector.Key() == basechain.Key())
+
+		common.AssertTrue(t, address.AddressKey{}.IsZero())
+		common.AssertFalse(t, sui.Key().IsZero())
+	})
+
+	t.Run("should key addresses longer than the key data", func(t *testing.T) {
+		long := strings.Repeat("ab", address.AddressKeyDataLength+1)
+		a := parse(t, long, chainid.Ecosystem(200))
+		common.AssertTrue(t, a.Length() == a.Key().Length())
+		common.AssertTrue(t, a.Key() == parse(t, "0x"+long, chainid.Ecosystem(200)).Key())
+		other := parse(t, long[:len(long)-2]+"ac", chainid.Ecosystem(200))
+		common.AssertFalse(t, a.Key() == other.Key())
+	})
+
+	t.Run("should be usable as map key", func(t *testing.T) {
+		balances := map[address.AddressKey]uint64{}
+		balances[parse(t, "EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2", chainid.EcosystemTon).Key()] += 1
+		balances[parse(t, "UQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p9dz", chainid.EcosystemTon).Key()] += 2
+		common.AssertTrue(t, len(balances) == 1)
+		common.AssertTrue(t, balances[parse(t, "0:ed1691307050047117b998b561d8de82d31fbf84910ced6eb5fc92e7485ef8a7", chainid.EcosystemTon).Key()] == 3)
+
+		evm := parse(t, "0x8236a87084f8B84306f72007F36F2618A5634494", chainid.EcosystemEVM)
+		ethereum, err := address.NewChainAddress(chainid.NewEVMEthereumLChainId(), evm)
+		common.AssertNoError(t, err)
+		base, err := address.NewChainAddress(chainid.NewEVMBaseLChainId(), evm)
+		common.AssertNoError(t, err)
+		same, err := address.ParseChainAddress(ethereum.String())
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, ethereum.Key() == same.Key())
+		common.AssertFalse(t, ethereum.Key() == base.Key())
+		common.AssertTrue(t, address.ChainAddress{}.Key() == address.ChainAddress{}.Key())
+	})
+
+	t.Run("should compare nil addresses", func(t *testing.T) {
+		ecosystems := []chainid.Ecosystem{
+			chainid.EcosystemEVM,
+			chainid.EcosystemSui,
+			chainid.EcosystemSolana,
+			chainid.EcosystemCosmos,
+			chainid.EcosystemStarknet,
+			chainid.EcosystemAptos,
+			chainid.EcosystemTon,
+			chainid.EcosystemTron,
+			chainid.EcosystemStellar,
+			chainid.EcosystemXrpl,
+			chainid.EcosystemSubstrate,
+			chainid.EcosystemNear,
+			chainid.EcosystemCardano,
+			chainid.EcosystemAlgorand,
+			chainid.EcosystemBitcoin,
+			chainid.Ecosystem(200),
+		}
+		for _, e := range ecosystems {
+			t.Run(e.String(), func(t *testing.T) {
+				a := address.NewZeroAddress(e)
+				common.AssertFalse(t, a.Equal(nil))
+				// nil pointer of the same type
+				typedNil := reflect.Zero(reflect.TypeOf(a)).Interface().(address.Address)
+				common.AssertFalse(t, a.Equal(typedNil))
+				common.AssertTrue(t, typedNil.Equal(nil))
+				common.AssertFalse(t, typedNil.Equal(a))
+			})
+		}
+		var utxo *address.UtxoAddress
+		common.AssertTrue(t, utxo.Equal(nil))
+	})
+}
+
+func TestAddressCompare(t *testing.T) {
+	parse := func(t *testing.T, s string, e chainid.Ecosystem) address.Address {
+		t.Helper()
+		a, err := address.NewAddressFromString(s, e)
+		common.AssertNoError(t, err)
+		return a
+	}
+	evm := parse(t, "0x8236a87084f8B84306f72007F36F2618A5634494", chainid.EcosystemEVM)
+	evmZero := address.NewZeroAddress(chainid.EcosystemEVM)
+	sui := parse(t, "0x0000000000000000000000000000000000000000000000000000000000000001", chainid.EcosystemSui)
+	bounceable := parse(t, "EQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p4q2", chainid.EcosystemTon)
+	nonBounceable := parse(t, "UQDtFpEwcFAEcRe5mLVh2N6C0x-_hJEM7W61_JLnSF74p9dz", chainid.EcosystemTon)
+
+	t.Run("should compare ecosystem first and then bytes", func(t *testing.T) {
+		common.AssertTrue(t, address.Compare(evmZero, evm) < 0)
+		common.AssertTrue(t, address.Compare(evm, evmZero) > 0)
+		common.AssertTrue(t, address.Compare(evm, sui) < 0)
+		common.AssertTrue(t, address.Compare(bounceable, nonBounceable) == 0)
+		common.AssertTrue(t, address.Compare(nil, evmZero) < 0)
+		common.AssertTrue(t, address.Compare(evmZero, nil) > 0)
+		common.AssertTrue(t, address.Compare(nil, nil) == 0)
+	})
+
+	t.Run("should collect addresses in a set", func(t *testing.T) {
+		s := address.NewAddressSet(bounceable, sui)
+		common.AssertFalse(t, s.Add(nonBounceable))
+		common.AssertTrue(t, s.Add(evm))
+		common.AssertTrue(t, s.Add(evmZero))
+		common.AssertTrue(t, s.Contains(nonBounceable))
+		common.AssertTrue(t, s.Len() == 4)
+
+		sorted := slices.Collect(s.All())
+		common.AssertTrue(t, slices.EqualFunc(sorted, []address.Address{evmZero, evm, sui, bounceable}, address.Address.Equal))
+		common.AssertTrue(t, slices.IsSortedFunc(sorted, address.Compare))
+
+		common.AssertTrue(t, s.Remove(nonBounceable))
+		common.AssertFalse(t, s.Contains(bounceable))
+		common.AssertFalse(t, s.Remove(bounceable))
+		common.AssertTrue(t, s.Len() == 3)
+
+		var empty address.AddressSet
+		common.AssertFalse(t, empty.Contains(evm))
+		common.AssertTrue(t, len(slices.Collect(empty.All())) == 0)
+	})
+
+	t.Run("should ignore nil addresses in a set", func(t *testing.T) {
+		var typedNil *address.EvmAddress
+		s := address.NewAddressSet(nil, evm, typedNil)
+		common.AssertTrue(t, s.Len() == 1)
+		common.AssertFalse(t, s.Add(nil))
+		common.AssertFalse(t, s.Add(typedNil))
+		common.AssertFalse(t, s.Contains(nil))
+		common.AssertFalse(t, s.Contains(typedNil))
+		common.AssertFalse(t, s.Remove(nil))
+		common.AssertFalse(t, s.Remove(typedNil))
+		common.AssertTrue(t, s.Len() == 1)
+
+		var empty address.AddressSet
+		common.AssertFalse(t, empty.Add(nil))
+		common.AssertFalse(t, empty.Remove(nil))
+	})
+}
+
 func TestGenericAddress(t *testing.T) {
 	validAddressString := "0xbfde966bacd4260852155f7b523ef157f0b75a0e1e8a0784e463c3ef0bb69deb"
-	ecosystem := chainid.Ecosystem(10)
+	ecosystem := chainid.Ecosystem(200)
 	anotherValidAddressString := "0x3e8e9423d80e1774a7ca128fccd8bf5f1f7753be658c5e645929037f7c819040"
-	anotherEcosystem := chainid.Ecosystem(11)
+	anotherEcosystem := chainid.Ecosystem(201)
 
 	t.Run("should create address from valid hex addresses", func(t *testing.T) {
 		addr, err := address.NewGenericAddressFromHex(validAddressString, ecosystem)
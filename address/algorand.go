@@ -0,0 +1,144 @@
+package address
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+)
+
+// AlgorandAddressLength is the length of an Algorand address, i.e. an ed25519 public key or the hash
+// identifying an application account
+const AlgorandAddressLength = 32
+
+// AlgorandChecksumLength is the length of the checksum appended to the address in its string form
+const AlgorandChecksumLength = 4
+
+// AlgorandEncodedLength is the length of the base32 string form of an address
+const AlgorandEncodedLength = 58
+
+// algorandAppIdPrefix is the domain separation prefix of application account derivation
+const algorandAppIdPrefix = "appID"
+
+var algorandEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ErrBadAddressAlgorand is an ErrBadAddress specialized for Algorand
+var ErrBadAddressAlgorand = fmt.Errorf("algorand %w", ErrBadAddress)
+
+// AlgorandAddress is the address type for the Algorand blockchain. The string form is the base32 encoding of
+// the 32 bytes of the address followed by the last 4 bytes of their SHA-512/256 hash.
+type AlgorandAddress struct {
+	inner [AlgorandAddressLength]byte
+}
+
+// NewAlgorandAddress creates a new AlgorandAddress from a slice of bytes
+func NewAlgorandAddress(b []byte) (*AlgorandAddress, error) {
+	if len(b) != AlgorandAddressLength {
+		return nil, fmt.Errorf("%w: length error, given %d, expected %d", ErrBadAddressAlgorand, len(b), AlgorandAddressLength)
+	}
+	a := &AlgorandAddress{}
+	copy(a.inner[:], b)
+	return a, nil
+}
+
+// NewAlgorandAddressFromHex creates a new AlgorandAddress from an hex string. Both string with
+// and without leading 0x are supported
+func NewAlgorandAddressFromHex(address string) (*AlgorandAddress, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: hex decoding error %w", ErrBadAddressAlgorand, err)
+	}
+	return NewAlgorandAddress(b)
+}
+
+// NewAlgorandAddressFromBase32 creates a new AlgorandAddress from its 58 chars base32 form, verifying the
+// checksum and rejecting non canonical encodings
+func NewAlgorandAddressFromBase32(address string) (*AlgorandAddress, error) {
+	if len(address) != AlgorandEncodedLength {
+		return nil, fmt.Errorf(
+			"%w: length error, given %d chars, expected %d",
+			ErrBadAddressAlgorand, len(address), AlgorandEncodedLength,
+		)
+	}
+	var decoded [AlgorandAddressLength + AlgorandChecksumLength]byte
+	if _, err := algorandEncoding.Decode(decoded[:], []byte(address)); err != nil {
+		return nil, fmt.Errorf("%w: base32 decoding error %w", ErrBadAddressAlgorand, err)
+	}
+	a := &AlgorandAddress{}
+	copy(a.inner[:], decoded[:AlgorandAddressLength])
+	checksum := a.checksum()
+	if !bytes.Equal(checksum[:], decoded[AlgorandAddressLength:]) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrBadAddressAlgorand)
+	}
+	// reject non canonical encodings, whose trailing bits are not zeroes
+	if a.String() != address {
+		return nil, fmt.Errorf("%w: non canonical encoding", ErrBadAddressAlgorand)
+	}
+	return a, nil
+}
+
+// NewAlgorandApplicationAddress returns the address of the account controlled by the application with the
+// given id, i.e. the SHA-512/256 hash of `appID` followed by the big endian id
+func NewAlgorandApplicationAddress(appId uint64) *AlgorandAddress {
+	preimage := binary.BigEndian.AppendUint64([]byte(algorandAppIdPrefix), appId)
+	return &AlgorandAddress{inner: sha512.Sum512_256(preimage)}
+}
+
+// checksum returns the last 4 bytes of the SHA-512/256 hash of the address
+func (a *AlgorandAddress) checksum() [AlgorandChecksumLength]byte {
+	hash := sha512.Sum512_256(a.inner[:])
+	return [AlgorandChecksumLength]byte(hash[len(hash)-AlgorandChecksumLength:])
+}
+
+// String returns the base32 encoding of the address with its checksum as common in the Algorand ecosystem
+func (a *AlgorandAddress) String() string {
+	checksum := a.checksum()
+	return algorandEncoding.EncodeToString(append(a.Bytes(), checksum[:]...))
+}
+
+func (a *AlgorandAddress) Hex() string {
+	return hex.EncodeToString(a.inner[:])
+}
+
+func (a *AlgorandAddress) Bytes() []byte {
+	buf := make([]byte, AlgorandAddressLength)
+	copy(buf, a.inner[:])
+	return buf
+}
+
+// Bytes32 returns the public key of the account
+func (a *AlgorandAddress) Bytes32() ([Bytes32Length]byte, error) {
+	return a.inner, nil
+}
+
+// Key returns the comparable representation of the address
+func (a *AlgorandAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *AlgorandAddress) Length() int {
+	return AlgorandAddressLength
+}
+
+func (a *AlgorandAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemAlgorand
+}
+
+func (a1 *AlgorandAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsAlgorand, ok := a2.(*AlgorandAddress)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(a1.inner[:], a2AsAlgorand.inner[:])
+}
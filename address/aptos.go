@@ -0,0 +1,119 @@
+package address
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+)
+
+const AptosAddressLength = 32
+
+// aptosMaxSpecialAddress is the last special address, i.e. the last address admitting the short form
+const aptosMaxSpecialAddress = 0x0f
+
+// ErrBadAddressAptos is an ErrBadAddress specialized for Aptos
+var ErrBadAddressAptos = fmt.Errorf("aptos %w", ErrBadAddress)
+
+// AptosAddress is the address type for the Aptos blockchain. String representation follows AIP-40, where
+// special addresses (0x0 to 0xf) are displayed in short form and any other address in long form.
+type AptosAddress struct {
+	inner [AptosAddressLength]byte
+}
+
+// NewAptosAddress creates a new AptosAddress from a slice of bytes
+func NewAptosAddress(b []byte) (*AptosAddress, error) {
+	if len(b) != AptosAddressLength {
+		return nil, fmt.Errorf("%w: length error, given %d, expected %d", ErrBadAddressAptos, len(b), AptosAddressLength)
+	}
+	a := &AptosAddress{}
+	copy(a.inner[:], b)
+	return a, nil
+}
+
+// NewAptosAddressFromHex creates a new AptosAddress from an hex string according to AIP-40. The long form
+// with 64 hex chars is accepted for any address, while the short form with a single hex char is accepted only
+// for special addresses 0x0 to 0xf. Both string with and without leading 0x are supported.
+func NewAptosAddressFromHex(address string) (*AptosAddress, error) {
+	trimmed := strings.TrimPrefix(address, "0x")
+	if len(trimmed) == 1 {
+		trimmed = "0" + trimmed
+		b, err := hex.DecodeString(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("%w: hex decoding error %w", ErrBadAddressAptos, err)
+		}
+		a := &AptosAddress{}
+		a.inner[AptosAddressLength-1] = b[0]
+		return a, nil
+	}
+	if len(trimmed) != AptosAddressLength*2 {
+		return nil, fmt.Errorf(
+			"%w: only special addresses admit the short form, given %d hex chars, expected %d",
+			ErrBadAddressAptos, len(trimmed), AptosAddressLength*2,
+		)
+	}
+	b, err := hex.DecodeString(trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: hex decoding error %w", ErrBadAddressAptos, err)
+	}
+	return NewAptosAddress(b)
+}
+
+// IsSpecial reports whether the address is among the special addresses 0x0 to 0xf, reserved by the framework
+func (a *AptosAddress) IsSpecial() bool {
+	return bytes.Equal(a.inner[:AptosAddressLength-1], make([]byte, AptosAddressLength-1)) &&
+		a.inner[AptosAddressLength-1] <= aptosMaxSpecialAddress
+}
+
+// String returns the AIP-40 representation of the address, i.e. the short form for special addresses
+// and the long form for all the others
+func (a *AptosAddress) String() string {
+	if a.IsSpecial() {
+		return fmt.Sprintf("0x%x", a.inner[AptosAddressLength-1])
+	}
+	return "0x" + a.Hex()
+}
+
+func (a *AptosAddress) Hex() string {
+	return hex.EncodeToString(a.inner[:])
+}
+
+func (a *AptosAddress) Bytes() []byte {
+	buf := make([]byte, AptosAddressLength)
+	copy(buf, a.inner[:])
+	return buf
+}
+
+// Bytes32 returns the bytes of the address
+func (a *AptosAddress) Bytes32() ([Bytes32Length]byte, error) {
+	return a.inner, nil
+}
+
+// Key returns the comparable representation of the address
+func (a *AptosAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *AptosAddress) Length() int {
+	return AptosAddressLength
+}
+
+func (a *AptosAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemAptos
+}
+
+func (a1 *AptosAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsAptos, ok := a2.(*AptosAddress)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(a1.inner[:], a2AsAptos.inner[:])
+}
@@ -0,0 +1,173 @@
+package address
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+// Bytes32Length is the length of the canonical representation of addresses in Lombard payloads
+const Bytes32Length = 32
+
+// ErrBadPadding is returned when the padding of a bytes32 address is not made of zeroes
+var ErrBadPadding = fmt.Errorf("%w: non-zero padding", ErrBadAddress)
+
+// ErrNonCanonicalBytes32 is returned when a bytes32 decodes to an address whose canonical representation differs
+var ErrNonCanonicalBytes32 = fmt.Errorf("%w: non canonical bytes32", ErrBadAddress)
+
+// ErrNotRepresentableBytes32 is returned when an address has no canonical bytes32 representation, e.g. because it
+// is longer than 32 bytes, or when a bytes32 does not represent any address of the ecosystem
+var ErrNotRepresentableBytes32 = fmt.Errorf("%w: not representable as bytes32", ErrBadAddress)
+
+// NewAddressFromBytes32 creates a new Address from its canonical bytes32 representation, as returned by
+// Address.Bytes32, applying the padding rules of the ecosystem. In particular:
+//   - EVM, Tron and Cosmos SDK accounts are the 20 bytes address left padded with zeroes
+//   - Sui, Solana, Starknet, Aptos, Substrate, Algorand, CosmWasm and Stellar accounts are the 32 bytes as-is
+//   - TON addresses are the account hash on the basechain
+//   - XRP Ledger addresses are the account id, preceded by the destination tag and a flags byte telling whether
+//     the tag is set. The network flag of X-addresses is not encoded, so that they are decoded for main networks.
+//   - NEAR implicit accounts are the 32 bytes public key
+//   - Cardano addresses up to 32 bytes are left padded with zeroes
+//   - Bitcoin P2PKH, P2SH and P2WPKH output scripts are left padded with zeroes
+//
+// Stellar contract ids are 32 bytes as well, so that they cannot be told apart from accounts: they are decoded as
+// accounts and should be created with NewStellarContractAddress when the payload is known to carry a contract.
+// Other addresses, such as TON addresses outside the basechain, Stellar muxed accounts, NEAR named accounts,
+// Cardano base addresses or Bitcoin P2WSH and P2TR output scripts, are not representable, so that their Bytes32
+// returns an ErrNotRepresentableBytes32. An error is returned as well if the padding is not made of zeroes, or if
+// the bytes32 is not the canonical representation of the decoded address.
+func NewAddressFromBytes32(b [Bytes32Length]byte, e chainid.Ecosystem) (Address, error) {
+	a, err := newAddressFromBytes32(b, e)
+	if err != nil {
+		return nil, err
+	}
+	canonical, err := a.Bytes32()
+	if err != nil {
+		return nil, err
+	}
+	if canonical != b {
+		return nil, fmt.Errorf("%w: %s address %s", ErrNonCanonicalBytes32, e, a.String())
+	}
+	return a, nil
+}
+
+func newAddressFromBytes32(b [Bytes32Length]byte, e chainid.Ecosystem) (Address, error) {
+	if codec, ok := LookupCodec(e); ok {
+		if codec.FromBytes32 != nil {
+			return codec.FromBytes32(b)
+		}
+		return codec.FromBytes(b[:])
+	}
+	if e == chainid.EcosystemBitcoin {
+		return newBitcoinAddressFromBytes32(b)
+	}
+	return NewGenericAddress(b[:], e)
+}
+
+// pad32 returns b left padded with zeroes, b being at most 32 bytes long
+func pad32(b []byte) [Bytes32Length]byte {
+	var out [Bytes32Length]byte
+	copy(out[Bytes32Length-len(b):], b)
+	return out
+}
+
+// unpad32 returns the least significant n bytes of b, verifying the others are zeroes
+func unpad32(b [Bytes32Length]byte, n int) ([]byte, error) {
+	if !bytes.Equal(b[:Bytes32Length-n], common.Bytes32Zeros[:Bytes32Length-n]) {
+		return nil, fmt.Errorf("%w: expected %d leading zeroes", ErrBadPadding, Bytes32Length-n)
+	}
+	return bytes.Clone(b[Bytes32Length-n:]), nil
+}
+
+func newEvmAddressFromBytes32(b [Bytes32Length]byte) (Address, error) {
+	inner, err := unpad32(b, EvmAddressLength)
+	if err != nil {
+		return nil, err
+	}
+	return NewEvmAddress(inner)
+}
+
+func newTronAddressFromBytes32(b [Bytes32Length]byte) (Address, error) {
+	inner, err := unpad32(b, TronAddressLength)
+	if err != nil {
+		return nil, err
+	}
+	return NewTronAddress(inner)
+}
+
+// xrplBytes32HasTag is the flag telling whether the destination tag is set, stored in the byte preceding it. The
+// network flag of X-addresses is not encoded, since it is not considered when comparing addresses.
+const xrplBytes32HasTag byte = 1 << 0
+
+// xrplBytes32FlagsOffset is the offset of the flags byte in the bytes32 of XRP Ledger addresses, followed by the
+// destination tag as big endian u32 and the account id
+const xrplBytes32FlagsOffset = Bytes32Length - XrplAccountIdLength - XrplTagLength - 1
+
+func newXrplAddressFromBytes32(b [Bytes32Length]byte) (Address, error) {
+	if _, err := unpad32(b, Bytes32Length-xrplBytes32FlagsOffset); err != nil {
+		return nil, err
+	}
+	flags := b[xrplBytes32FlagsOffset]
+	if flags&^xrplBytes32HasTag != 0 {
+		return nil, fmt.Errorf("%w: unsupported bytes32 flags %#x", ErrBadAddressXrpl, flags)
+	}
+	a, err := NewXrplAddress(b[Bytes32Length-XrplAccountIdLength:])
+	if err != nil {
+		return nil, err
+	}
+	tag := binary.BigEndian.Uint32(b[xrplBytes32FlagsOffset+1:])
+	if flags&xrplBytes32HasTag != 0 {
+		a = a.WithTag(tag)
+	} else if tag != 0 {
+		return nil, fmt.Errorf("%w: tag is set but flagged as missing", ErrBadAddressXrpl)
+	}
+	return a, nil
+}
+
+func newNearAddressFromBytes32(b [Bytes32Length]byte) (Address, error) {
+	// only implicit accounts are representable, so that the 32 bytes are always the public key
+	return NewNearImplicitAddress(b[:])
+}
+
+func newCardanoAddressFromBytes32(b [Bytes32Length]byte) (Address, error) {
+	// headers of addresses fitting 32 bytes are never zero
+	return NewCardanoAddress(bytes.TrimLeft(b[:], "\x00"))
+}
+
+// bitcoinBytes32ScriptLengths are the lengths of the standard output scripts fitting 32 bytes, i.e. P2PKH, P2SH
+// and P2WPKH
+var bitcoinBytes32ScriptLengths = []int{25, 23, 22}
+
+// bitcoinBytes32 returns the output script left padded with zeroes if it is a standard script fitting 32 bytes,
+// which are told apart by their first opcode once padded
+func bitcoinBytes32(script []byte) ([Bytes32Length]byte, error) {
+	if !slices.Contains(bitcoinBytes32ScriptLengths, len(script)) {
+		return [Bytes32Length]byte{}, fmt.Errorf(
+			"%w: bitcoin output script of %d bytes", ErrNotRepresentableBytes32, len(script),
+		)
+	}
+	// network params only affect the string form
+	if _, err := NewUtxoAddressFromScript(script, chainid.BitcoinMainnetParams); err != nil {
+		return [Bytes32Length]byte{}, fmt.Errorf("%w: %w", ErrNotRepresentableBytes32, err)
+	}
+	return pad32(script), nil
+}
+
+// newBitcoinAddressFromBytes32 returns the GenericAddress of the padded standard output script, since addresses of
+// the Bitcoin ecosystem are generic by default
+func newBitcoinAddressFromBytes32(b [Bytes32Length]byte) (Address, error) {
+	for _, n := range bitcoinBytes32ScriptLengths {
+		script, err := unpad32(b, n)
+		if err != nil {
+			continue
+		}
+		if _, err := NewUtxoAddressFromScript(script, chainid.BitcoinMainnetParams); err == nil {
+			return NewGenericAddress(script, chainid.EcosystemBitcoin)
+		}
+	}
+	return nil, fmt.Errorf("%w: no padded p2pkh, p2sh or p2wpkh output script", ErrNotRepresentableBytes32)
+}
@@ -0,0 +1,385 @@
+package address
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/base58"
+	"github.com/lombard-finance/ledger-utils/common/bech32"
+)
+
+// CardanoHashLength is the length of the blake2b-224 hash of keys and scripts used as credentials
+const CardanoHashLength = 28
+
+// Bech32 human readable parts of Shelley addresses according to CIP-5
+const (
+	CardanoMainnetPrefix       = "addr"
+	CardanoTestnetPrefix       = "addr_test"
+	CardanoMainnetRewardPrefix = "stake"
+	CardanoTestnetRewardPrefix = "stake_test"
+)
+
+// cardanoMaxBech32Length is longer than any valid Shelley address, including pointer addresses whose
+// variable length naturals exceed the 90 chars limit of BIP-173
+const cardanoMaxBech32Length = 128
+
+// cardanoMaxByronLength is longer than any valid Byron address, bounding the base58 decoding of unknown strings
+const cardanoMaxByronLength = 256
+
+// cardanoByronPrefix is the CBOR header of Byron addresses, i.e. an array of two items whose first item is
+// the tag 24 of encoded CBOR
+var cardanoByronPrefix = []byte{0x82, 0xd8, 0x18}
+
+// CardanoAddressType is the type of a Cardano address, i.e. the 4 most significant bits of its header byte
+// as defined by CIP-19
+type CardanoAddressType byte
+
+const (
+	// CardanoBaseKeyKey is a base address with key payment credential and key stake credential
+	CardanoBaseKeyKey CardanoAddressType = 0
+	// CardanoBaseScriptKey is a base address with script payment credential and key stake credential
+	CardanoBaseScriptKey CardanoAddressType = 1
+	// CardanoBaseKeyScript is a base address with key payment credential and script stake credential
+	CardanoBaseKeyScript CardanoAddressType = 2
+	// CardanoBaseScriptScript is a base address with script payment credential and script stake credential
+	CardanoBaseScriptScript CardanoAddressType = 3
+	// CardanoPointerKey is a pointer address with key payment credential
+	CardanoPointerKey CardanoAddressType = 4
+	// CardanoPointerScript is a pointer address with script payment credential
+	CardanoPointerScript CardanoAddressType = 5
+	// CardanoEnterpriseKey is an enterprise address with key payment credential and no stake credential
+	CardanoEnterpriseKey CardanoAddressType = 6
+	// CardanoEnterpriseScript is an enterprise address with script payment credential and no stake credential
+	CardanoEnterpriseScript CardanoAddressType = 7
+	// CardanoByron is a legacy Byron address, which is not supported
+	CardanoByron CardanoAddressType = 8
+	// CardanoRewardKey is a reward account address with key stake credential
+	CardanoRewardKey CardanoAddressType = 14
+	// CardanoRewardScript is a reward account address with script stake credential
+	CardanoRewardScript CardanoAddressType = 15
+)
+
+func (t CardanoAddressType) String() string {
+	switch t {
+	case CardanoBaseKeyKey, CardanoBaseScriptKey, CardanoBaseKeyScript, CardanoBaseScriptScript:
+		return "base"
+	case CardanoPointerKey, CardanoPointerScript:
+		return "pointer"
+	case CardanoEnterpriseKey, CardanoEnterpriseScript:
+		return "enterprise"
+	case CardanoByron:
+		return "byron"
+	case CardanoRewardKey, CardanoRewardScript:
+		return "reward"
+	default:
+		return fmt.Sprintf("type %d", t)
+	}
+}
+
+// IsReward reports whether the type is a reward account address, which has no payment credential
+func (t CardanoAddressType) IsReward() bool {
+	return t == CardanoRewardKey || t == CardanoRewardScript
+}
+
+// CardanoCredential is a payment or stake credential, i.e. the hash of either a verification key or a script
+type CardanoCredential struct {
+	Hash     [CardanoHashLength]byte
+	IsScript bool
+}
+
+// CardanoPointer is the location of the stake registration certificate referenced by pointer addresses
+type CardanoPointer struct {
+	Slot      uint64
+	TxIndex   uint64
+	CertIndex uint64
+}
+
+// ErrBadAddressCardano is an ErrBadAddress specialized for Cardano
+var ErrBadAddressCardano = fmt.Errorf("cardano %w", ErrBadAddress)
+
+// ErrCardanoByronAddress is returned when a Byron address is given in place of a Shelley address
+var ErrCardanoByronAddress = fmt.Errorf("%w: byron addresses are not supported", ErrBadAddressCardano)
+
+// ErrCardanoNetworkMismatch is returned when the network id of an address does not match the chain
+var ErrCardanoNetworkMismatch = fmt.Errorf("%w: network id mismatch", ErrBadAddressCardano)
+
+// CardanoAddress is the address type for Cardano Shelley addresses: base, pointer, enterprise and reward
+// addresses. Bytes are the raw address, i.e. the header byte followed by the credentials, while the string
+// form is its bech32 encoding, which is not bound to the 90 chars limit of BIP-173.
+type CardanoAddress struct {
+	typ       CardanoAddressType
+	networkId uint8
+	payment   CardanoCredential
+	stake     CardanoCredential
+	pointer   CardanoPointer
+}
+
+// NewCardanoAddress creates a new CardanoAddress from the raw address bytes
+func NewCardanoAddress(b []byte) (*CardanoAddress, error) {
+	if len(b) == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrBadAddressCardano, ErrEmptyAddress)
+	}
+	if bytes.HasPrefix(b, cardanoByronPrefix) {
+		return nil, ErrCardanoByronAddress
+	}
+	a := &CardanoAddress{typ: CardanoAddressType(b[0] >> 4), networkId: b[0] & 0x0f}
+	if a.networkId != chainid.CardanoMainnetNetworkId && a.networkId != chainid.CardanoTestnetNetworkId {
+		return nil, fmt.Errorf("%w: unsupported network id %d", ErrBadAddressCardano, a.networkId)
+	}
+	payload := b[1:]
+	expectedLength := CardanoHashLength
+	switch a.typ {
+	case CardanoBaseKeyKey, CardanoBaseScriptKey, CardanoBaseKeyScript, CardanoBaseScriptScript:
+		expectedLength = 2 * CardanoHashLength
+	case CardanoPointerKey, CardanoPointerScript:
+		if len(payload) <= CardanoHashLength {
+			return nil, fmt.Errorf("%w: pointer address without pointer", ErrBadAddressCardano)
+		}
+		pointer, err := decodeCardanoPointer(payload[CardanoHashLength:])
+		if err != nil {
+			return nil, err
+		}
+		a.pointer = pointer
+		expectedLength = len(payload)
+	case CardanoEnterpriseKey, CardanoEnterpriseScript, CardanoRewardKey, CardanoRewardScript:
+	case CardanoByron:
+		return nil, ErrCardanoByronAddress
+	default:
+		return nil, fmt.Errorf("%w: unsupported address type %d", ErrBadAddressCardano, a.typ)
+	}
+	if len(payload) != expectedLength {
+		return nil, fmt.Errorf(
+			"%w: %s address length error, given %d, expected %d",
+			ErrBadAddressCardano, a.typ, len(payload), expectedLength,
+		)
+	}
+
+	// the header bits tell whether each credential is a key or a script hash
+	switch {
+	case a.typ.IsReward():
+		copy(a.stake.Hash[:], payload)
+		a.stake.IsScript = a.typ == CardanoRewardScript
+	default:
+		copy(a.payment.Hash[:], payload)
+		a.payment.IsScript = a.typ&0x01 != 0
+		if a.typ <= CardanoBaseScriptScript {
+			copy(a.stake.Hash[:], payload[CardanoHashLength:])
+			a.stake.IsScript = a.typ&0x02 != 0
+		}
+	}
+	return a, nil
+}
+
+// NewCardanoAddressFromBech32 creates a new CardanoAddress from its bech32 representation, verifying that the
+// human readable part matches the address type and network. Byron addresses are detected and rejected with
+// ErrCardanoByronAddress.
+func NewCardanoAddressFromBech32(address string) (*CardanoAddress, error) {
+	hrp, decoded, v, err := bech32.DecodeVariant(address, cardanoMaxBech32Length)
+	if err != nil {
+		if isCardanoByronAddress(address) {
+			return nil, ErrCardanoByronAddress
+		}
+		return nil, fmt.Errorf("%w: bech32 decoding error %w", ErrBadAddressCardano, err)
+	}
+	if v != bech32.Bech32 {
+		return nil, fmt.Errorf("%w: expected %s, given %s", ErrBadAddressCardano, bech32.Bech32, v)
+	}
+	a, err := NewCardanoAddress(decoded)
+	if err != nil {
+		return nil, err
+	}
+	if expected := a.prefix(); hrp != expected {
+		return nil, fmt.Errorf("%w: bech32 prefix mismatch, given %s, expected %s", ErrBadAddressCardano, hrp, expected)
+	}
+	return a, nil
+}
+
+// isCardanoByronAddress reports whether the string is the base58 encoding of a Byron address
+func isCardanoByronAddress(address string) bool {
+	if len(address) > cardanoMaxByronLength {
+		return false
+	}
+	decoded, err := base58.Decode(address)
+	return err == nil && bytes.HasPrefix(decoded, cardanoByronPrefix)
+}
+
+// decodeCardanoPointer decodes the three variable length naturals of a pointer, rejecting trailing bytes
+// and non canonical encodings
+func decodeCardanoPointer(b []byte) (CardanoPointer, error) {
+	var values [3]uint64
+	for i := range values {
+		if len(b) == 0 {
+			return CardanoPointer{}, fmt.Errorf("%w: truncated pointer", ErrBadAddressCardano)
+		}
+		if b[0] == 0x80 {
+			return CardanoPointer{}, fmt.Errorf("%w: non canonical pointer", ErrBadAddressCardano)
+		}
+		var value uint64
+		for {
+			if len(b) == 0 {
+				return CardanoPointer{}, fmt.Errorf("%w: truncated pointer", ErrBadAddressCardano)
+			}
+			if value>>57 != 0 {
+				return CardanoPointer{}, fmt.Errorf("%w: pointer overflow", ErrBadAddressCardano)
+			}
+			value = value<<7 | uint64(b[0]&0x7f)
+			last := b[0]&0x80 == 0
+			b = b[1:]
+			if last {
+				break
+			}
+		}
+		values[i] = value
+	}
+	if len(b) != 0 {
+		return CardanoPointer{}, fmt.Errorf("%w: trailing bytes after pointer", ErrBadAddressCardano)
+	}
+	return CardanoPointer{Slot: values[0], TxIndex: values[1], CertIndex: values[2]}, nil
+}
+
+// appendCardanoNatural appends the variable length encoding of v, i.e. big endian groups of 7 bits where
+// all the bytes but the last have the most significant bit set
+func appendCardanoNatural(b []byte, v uint64) []byte {
+	var buf [10]byte
+	i := len(buf) - 1
+	buf[i] = byte(v & 0x7f)
+	for v >>= 7; v != 0; v >>= 7 {
+		i--
+		buf[i] = byte(v&0x7f) | 0x80
+	}
+	return append(b, buf[i:]...)
+}
+
+// Type returns the type of the address
+func (a *CardanoAddress) Type() CardanoAddressType {
+	return a.typ
+}
+
+// NetworkId returns the network id of the address, i.e. 1 for the mainnet and 0 for testnets
+func (a *CardanoAddress) NetworkId() uint8 {
+	return a.networkId
+}
+
+// PaymentCredential returns the payment credential and whether the address has one, which is the case for all
+// the types but reward addresses
+func (a *CardanoAddress) PaymentCredential() (CardanoCredential, bool) {
+	if a.typ.IsReward() {
+		return CardanoCredential{}, false
+	}
+	return a.payment, true
+}
+
+// StakeCredential returns the stake credential and whether the address has one, which is the case for base and
+// reward addresses
+func (a *CardanoAddress) StakeCredential() (CardanoCredential, bool) {
+	if a.typ > CardanoBaseScriptScript && !a.typ.IsReward() {
+		return CardanoCredential{}, false
+	}
+	return a.stake, true
+}
+
+// StakePointer returns the stake pointer and whether the address is a pointer address
+func (a *CardanoAddress) StakePointer() (CardanoPointer, bool) {
+	if a.typ != CardanoPointerKey && a.typ != CardanoPointerScript {
+		return CardanoPointer{}, false
+	}
+	return a.pointer, true
+}
+
+// CheckNetwork returns ErrCardanoNetworkMismatch if the network id of the address does not match the one of
+// the given chain
+func (a *CardanoAddress) CheckNetwork(c chainid.CardanoLChainId) error {
+	if a.networkId != c.NetworkId() {
+		return fmt.Errorf(
+			"%w: address network id %d, chain network id %d",
+			ErrCardanoNetworkMismatch, a.networkId, c.NetworkId(),
+		)
+	}
+	return nil
+}
+
+// prefix returns the bech32 human readable part of the address
+func (a *CardanoAddress) prefix() string {
+	mainnet := a.networkId == chainid.CardanoMainnetNetworkId
+	switch {
+	case a.typ.IsReward() && mainnet:
+		return CardanoMainnetRewardPrefix
+	case a.typ.IsReward():
+		return CardanoTestnetRewardPrefix
+	case mainnet:
+		return CardanoMainnetPrefix
+	default:
+		return CardanoTestnetPrefix
+	}
+}
+
+// String returns the bech32 representation of the address
+func (a *CardanoAddress) String() string {
+	// human readable part is always valid
+	s, _ := bech32.Encode(a.prefix(), a.Bytes())
+	return s
+}
+
+func (a *CardanoAddress) Hex() string {
+	return hex.EncodeToString(a.Bytes())
+}
+
+// Bytes returns the raw address, i.e. the header byte followed by the credentials or the pointer
+func (a *CardanoAddress) Bytes() []byte {
+	buf := make([]byte, 0, 1+2*CardanoHashLength)
+	buf = append(buf, byte(a.typ)<<4|a.networkId)
+	if a.typ.IsReward() {
+		return append(buf, a.stake.Hash[:]...)
+	}
+	buf = append(buf, a.payment.Hash[:]...)
+	switch a.typ {
+	case CardanoBaseKeyKey, CardanoBaseScriptKey, CardanoBaseKeyScript, CardanoBaseScriptScript:
+		buf = append(buf, a.stake.Hash[:]...)
+	case CardanoPointerKey, CardanoPointerScript:
+		buf = appendCardanoNatural(buf, a.pointer.Slot)
+		buf = appendCardanoNatural(buf, a.pointer.TxIndex)
+		buf = appendCardanoNatural(buf, a.pointer.CertIndex)
+	}
+	return buf
+}
+
+// Bytes32 returns the raw address left padded with zeroes, its header being never zero. Addresses longer than
+// 32 bytes, such as base addresses, are not representable.
+func (a *CardanoAddress) Bytes32() ([Bytes32Length]byte, error) {
+	b := a.Bytes()
+	if len(b) > Bytes32Length {
+		return [Bytes32Length]byte{}, fmt.Errorf(
+			"%w: cardano %s address of %d bytes", ErrNotRepresentableBytes32, a.typ, len(b),
+		)
+	}
+	return pad32(b), nil
+}
+
+// Key returns the comparable representation of the address
+func (a *CardanoAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *CardanoAddress) Length() int {
+	return len(a.Bytes())
+}
+
+func (a *CardanoAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemCardano
+}
+
+func (a1 *CardanoAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsCardano, ok := a2.(*CardanoAddress)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(a1.Bytes(), a2AsCardano.Bytes())
+}
@@ -0,0 +1,218 @@
+package address
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/bech32"
+)
+
+// ErrChainMismatch is returned when an address is valid in its ecosystem but not on a particular chain
+var ErrChainMismatch = fmt.Errorf("%w: not valid on chain", ErrBadAddress)
+
+// ErrEcosystemMismatch is returned when the address and the chain belong to different ecosystems
+var ErrEcosystemMismatch = fmt.Errorf("%w: ecosystem mismatch", ErrChainMismatch)
+
+// ErrNetworkMismatch is returned when the address belongs to another network of the same ecosystem, e.g. a
+// testnet address on mainnet
+var ErrNetworkMismatch = fmt.Errorf("%w: network mismatch", ErrChainMismatch)
+
+// ErrPrefixMismatch is returned when the address is encoded with the prefix of another chain, e.g. the bech32
+// prefix of Cosmos chains or the SS58 prefix of Substrate chains
+var ErrPrefixMismatch = fmt.Errorf("%w: prefix mismatch", ErrChainMismatch)
+
+// ErrAddressKindNotAllowed is returned when the kind of address is not supported by the chain, e.g. taproot
+// addresses on a network without taproot
+var ErrAddressKindNotAllowed = fmt.Errorf("%w: address kind not allowed", ErrChainMismatch)
+
+// ParseAddressForChain creates a new Address from its string representation on the given chain. Unlike
+// NewAddressFromString, the rules of the chain are enforced when known:
+//   - Bitcoin-like chains with known UtxoNetworkParams, where the address is parsed according to them
+//   - Cosmos chains with known bech32 prefix, where the prefix must match
+//   - Substrate chains with known SS58 prefix, where the prefix must match or be the generic one
+//   - XRP Ledger, where the network flag of X-addresses must match
+//
+// and the parsed address is checked with ValidateAddressForChain. Chains with unknown rules are treated as
+// their ecosystem.
+func ParseAddressForChain(id chainid.LChainId, s string) (Address, error) {
+	a, err := parseAddressForChain(id, s)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateAddressForChain(id, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func parseAddressForChain(id chainid.LChainId, s string) (Address, error) {
+	switch c := id.(type) {
+	case chainid.BitcoinLChainId:
+		if params, ok := c.NetworkParams(); ok {
+			return parseUtxoAddressForChain(s, params)
+		}
+	case chainid.CosmosLChainId:
+		if info, ok := c.Info(); ok && !strings.HasPrefix(s, "0x") {
+			return parseCosmosAddressForChain(s, info)
+		}
+	case chainid.SubstrateLChainId:
+		if prefix, ok := c.SS58Prefix(); ok {
+			return parseSS58AddressForChain(s, prefix)
+		}
+	case chainid.XrplLChainId:
+		return parseXrplAddressForChain(s, c)
+	}
+	return NewAddressFromString(s, id.Ecosystem())
+}
+
+func parseUtxoAddressForChain(s string, params chainid.UtxoNetworkParams) (Address, error) {
+	a, err := NewUtxoAddressFromString(s, params)
+	if err == nil {
+		return a, nil
+	}
+	if errors.Is(err, ErrUtxoUnsupportedByNetwork) {
+		return nil, fmt.Errorf("%w: %w", ErrAddressKindNotAllowed, err)
+	}
+	// tell apart addresses of other known networks from malformed ones
+	for _, other := range chainid.KnownUtxoNetworkParams() {
+		if other == params {
+			continue
+		}
+		if _, otherErr := NewUtxoAddressFromString(s, other); otherErr == nil {
+			return nil, fmt.Errorf("%w: %s address on %s", ErrNetworkMismatch, other.Name, params.Name)
+		}
+	}
+	return nil, err
+}
+
+func parseCosmosAddressForChain(s string, info chainid.CosmosChainInfo) (Address, error) {
+	hrp, decoded, err := bech32.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bech32 decoding error %w", ErrBadAddressCosmos, err)
+	}
+	if hrp != info.Bech32Prefix {
+		return nil, fmt.Errorf(
+			"%w: bech32 prefix given %s, expected %s for %s",
+			ErrPrefixMismatch, hrp, info.Bech32Prefix, info.ChainName,
+		)
+	}
+	return NewCosmosAddress(decoded)
+}
+
+func parseSS58AddressForChain(s string, prefix uint16) (Address, error) {
+	a, err := NewSS58AddressFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSS58PrefixForChain(a, prefix); err != nil {
+		return nil, err
+	}
+	// prefix is known to be valid
+	return a.WithPrefix(prefix)
+}
+
+func checkSS58PrefixForChain(a *SS58Address, prefix uint16) error {
+	if a.Prefix() != prefix && a.Prefix() != SS58GenericPrefix {
+		return fmt.Errorf("%w: ss58 prefix given %d, expected %d", ErrPrefixMismatch, a.Prefix(), prefix)
+	}
+	return nil
+}
+
+func parseXrplAddressForChain(s string, c chainid.XrplLChainId) (Address, error) {
+	a, err := NewXrplAddressFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	// X-addresses carry the network flag explicitly, so that it must match the chain in both directions
+	testnet := !c.Equal(chainid.NewXrplMainnetLChainId())
+	if !strings.HasPrefix(s, "r") && a.IsTestnet() != testnet {
+		return nil, fmt.Errorf("%w: x-address testnet flag %t on network %d", ErrNetworkMismatch, a.IsTestnet(), c.NetworkId())
+	}
+	return a, nil
+}
+
+// ValidateAddressForChain checks that the address can be used on the given chain, returning an ErrChainMismatch
+// explaining the mismatch otherwise. Besides the ecosystem, the following rules are checked when known:
+//   - Bitcoin-like chains, where the address must be represented for the network and its kind supported
+//   - Substrate chains, where the SS58 prefix must match or be the generic one
+//   - Cardano, TON and XRP Ledger, where testnet addresses are rejected on mainnet
+//   - NEAR, where named accounts under the top-level account of the other network are rejected
+func ValidateAddressForChain(id chainid.LChainId, a Address) error {
+	if a == nil {
+		return ErrEmptyAddress
+	}
+	if a.Ecosystem() != id.Ecosystem() {
+		return fmt.Errorf("%w: address of %s on chain of %s", ErrEcosystemMismatch, a.Ecosystem(), id.Ecosystem())
+	}
+	switch c := id.(type) {
+	case chainid.BitcoinLChainId:
+		if params, ok := c.NetworkParams(); ok {
+			return validateUtxoAddressForChain(a, params)
+		}
+	case chainid.SubstrateLChainId:
+		if prefix, ok := c.SS58Prefix(); ok {
+			if ss58, ok := a.(*SS58Address); ok {
+				return checkSS58PrefixForChain(ss58, prefix)
+			}
+		}
+	case chainid.CardanoLChainId:
+		if cardano, ok := a.(*CardanoAddress); ok {
+			if err := cardano.CheckNetwork(c); err != nil {
+				return fmt.Errorf("%w: %w", ErrNetworkMismatch, err)
+			}
+		}
+	case chainid.TonLChainId:
+		if ton, ok := a.(*TonAddress); ok && ton.IsTestnetOnly() && c.Equal(chainid.NewTonMainnetLChainId()) {
+			return fmt.Errorf("%w: testnet only address on ton mainnet", ErrNetworkMismatch)
+		}
+	case chainid.XrplLChainId:
+		if xrpl, ok := a.(*XrplAddress); ok && xrpl.IsTestnet() && c.Equal(chainid.NewXrplMainnetLChainId()) {
+			return fmt.Errorf("%w: testnet x-address on xrpl mainnet", ErrNetworkMismatch)
+		}
+	case chainid.NearLChainId:
+		if near, ok := a.(*NearAddress); ok {
+			return validateNearAddressForChain(near, c)
+		}
+	}
+	return nil
+}
+
+func validateUtxoAddressForChain(a Address, params chainid.UtxoNetworkParams) error {
+	if utxo, ok := a.(*UtxoAddress); ok {
+		if utxo.Params() != params {
+			return fmt.Errorf("%w: %s address on %s", ErrNetworkMismatch, utxo.Params().Name, params.Name)
+		}
+		return nil
+	}
+	// addresses of the Bitcoin ecosystem are generic by default, carrying the output script
+	if _, err := NewUtxoAddressFromScript(a.Bytes(), params); err != nil {
+		if errors.Is(err, ErrUtxoUnsupportedByNetwork) {
+			return fmt.Errorf("%w: %w", ErrAddressKindNotAllowed, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// nearTopLevelAccounts are the top-level accounts under which named accounts are created on each network
+var nearTopLevelAccounts = map[chainid.NearLChainId]string{
+	chainid.NewNearMainnetLChainId(): "near",
+	chainid.NewNearTestnetLChainId(): "testnet",
+}
+
+func validateNearAddressForChain(a *NearAddress, c chainid.NearLChainId) error {
+	if a.Kind() != NearNamedAccount {
+		return nil
+	}
+	for other, topLevel := range nearTopLevelAccounts {
+		if other == c {
+			continue
+		}
+		if a.AccountId() == topLevel || strings.HasSuffix(a.AccountId(), "."+topLevel) {
+			return fmt.Errorf("%w: account %s is under the top-level account %s", ErrNetworkMismatch, a.AccountId(), topLevel)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+)
+
+// ChainAddressSeparator separates the chain id from the native address in the string form of a ChainAddress
+const ChainAddressSeparator = ":"
+
+// ErrBadChainAddress is returned when the string form of a ChainAddress is malformed
+var ErrBadChainAddress = fmt.Errorf("%w: bad chain address", ErrBadAddress)
+
+// ChainAddress binds an Address to the chain it is used on, e.g. the recipient of a transfer on a destination
+// chain. The zero value is the empty ChainAddress, while instances created by NewChainAddress always carry an
+// address valid on the chain.
+type ChainAddress struct {
+	chain   chainid.LChainId
+	address Address
+}
+
+// NewChainAddress creates a new ChainAddress, verifying the address can be used on the chain with
+// ValidateAddressForChain, so that at least the ecosystems match. Addresses of Bitcoin-like chains with known
+// UtxoNetworkParams are converted to UtxoAddress, so that they are represented for the network.
+func NewChainAddress(id chainid.LChainId, a Address) (ChainAddress, error) {
+	if id == nil {
+		return ChainAddress{}, fmt.Errorf("%w: missing chain id", ErrBadChainAddress)
+	}
+	if err := ValidateAddressForChain(id, a); err != nil {
+		return ChainAddress{}, err
+	}
+	if c, ok := id.(chainid.BitcoinLChainId); ok {
+		if params, ok := c.NetworkParams(); ok {
+			if _, isUtxo := a.(*UtxoAddress); !isUtxo {
+				// script is known to be valid for the network
+				utxo, err := NewUtxoAddressFromScript(a.Bytes(), params)
+				if err != nil {
+					return ChainAddress{}, err
+				}
+				a = utxo
+			}
+		}
+	}
+	return ChainAddress{chain: id, address: a}, nil
+}
+
+// ParseChainAddress creates a new ChainAddress from its string form `0x<chain id>:<address>`, where the address
+// is parsed with ParseAddressForChain. The chain id is accepted in the notation `<ecosystem>:<native id>` as well,
+// e.g. `evm:1:0x...`, see chainid.ParseLChainId.
+func ParseChainAddress(s string) (ChainAddress, error) {
+	chainString, addressString, found := strings.Cut(s, ChainAddressSeparator)
+	if !found {
+		return ChainAddress{}, fmt.Errorf("%w: expected 0x<chain id>%s<address>", ErrBadChainAddress, ChainAddressSeparator)
+	}
+	if _, isNotation := chainid.LookupEcosystemByName(chainString); isNotation {
+		// the native id follows the ecosystem, while the address may contain the separator
+		native, rest, found := strings.Cut(addressString, ChainAddressSeparator)
+		if !found {
+			return ChainAddress{}, fmt.Errorf("%w: expected <ecosystem>:<native id>:<address>", ErrBadChainAddress)
+		}
+		chainString, addressString = chainString+chainid.NotationSeparator+native, rest
+	}
+	id, err := chainid.ParseLChainId(chainString)
+	if err != nil {
+		return ChainAddress{}, fmt.Errorf("%w: %w", ErrBadChainAddress, err)
+	}
+	a, err := ParseAddressForChain(id, addressString)
+	if err != nil {
+		return ChainAddress{}, err
+	}
+	return NewChainAddress(id, a)
+}
+
+// Chain returns the chain id, nil for the empty ChainAddress
+func (c ChainAddress) Chain() chainid.LChainId {
+	return c.chain
+}
+
+// Address returns the address, nil for the empty ChainAddress
+func (c ChainAddress) Address() Address {
+	return c.address
+}
+
+// IsZero reports whether c is the empty ChainAddress
+func (c ChainAddress) IsZero() bool {
+	return c.chain == nil
+}
+
+// String returns the form `0x<chain id>:<address>`, where the address is represented as in its ecosystem. The
+// empty ChainAddress is represented by the empty string.
+func (c ChainAddress) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	return c.chain.String() + ChainAddressSeparator + c.address.String()
+}
+
+// Equal reports whether c and other are the same address on the same chain
+func (c ChainAddress) Equal(other ChainAddress) bool {
+	if c.IsZero() || other.IsZero() {
+		return c.IsZero() && other.IsZero()
+	}
+	return c.chain.Equal(other.chain) && c.address.Equal(other.address)
+}
+
+// ChainAddressKey is a comparable representation of a ChainAddress, so that it can be used as map key or compared
+// with ==
+type ChainAddressKey struct {
+	chain   [chainid.ChainIdLength]byte
+	address AddressKey
+}
+
+// Key returns the comparable representation of the ChainAddress. Two instances have the same key if and only if
+// they are Equal.
+func (c ChainAddress) Key() ChainAddressKey {
+	if c.IsZero() {
+		return ChainAddressKey{}
+	}
+	return ChainAddressKey{chain: c.chain.FixedBytes(), address: c.address.Key()}
+}
+
+// MarshalText implements encoding.TextMarshaler, so that ChainAddress is encoded as a JSON string and can be used
+// as JSON object key
+func (c ChainAddress) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding the string form. The empty string is decoded as the
+// empty ChainAddress.
+func (c *ChainAddress) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*c = ChainAddress{}
+		return nil
+	}
+	parsed, err := ParseChainAddress(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,100 @@
+package address
+
+import (
+	"bytes"
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Compare returns -1, 0 or +1 depending on whether a sorts before, equal to or after b. Addresses are sorted by
+// ecosystem first and then by bytes. A nil Address sorts before any other.
+func Compare(a, b Address) int {
+	switch nilA, nilB := isNil(a), isNil(b); {
+	case nilA && nilB:
+		return 0
+	case nilA:
+		return -1
+	case nilB:
+		return 1
+	}
+	if c := cmp.Compare(a.Ecosystem(), b.Ecosystem()); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.Bytes(), b.Bytes())
+}
+
+// AddressSet is a set of addresses of any ecosystem, normalized via Key so that the different representations of
+// an address are the same element. Iteration is sorted according to Compare. The zero value is an empty set ready
+// to use. A nil Address is never an element of the set.
+type AddressSet struct {
+	m map[AddressKey]Address
+}
+
+// NewAddressSet creates a new AddressSet holding the given addresses
+func NewAddressSet(addresses ...Address) *AddressSet {
+	s := &AddressSet{}
+	for _, a := range addresses {
+		s.Add(a)
+	}
+	return s
+}
+
+// Add adds the address to the set, reporting whether it was not already present. A nil Address is ignored.
+func (s *AddressSet) Add(a Address) bool {
+	if isNil(a) {
+		return false
+	}
+	if s.m == nil {
+		s.m = make(map[AddressKey]Address)
+	}
+	key := a.Key()
+	if _, ok := s.m[key]; ok {
+		return false
+	}
+	s.m[key] = a
+	return true
+}
+
+// Remove removes the address from the set, reporting whether it was present
+func (s *AddressSet) Remove(a Address) bool {
+	if isNil(a) {
+		return false
+	}
+	key := a.Key()
+	if _, ok := s.m[key]; !ok {
+		return false
+	}
+	delete(s.m, key)
+	return true
+}
+
+// Contains reports whether the address is in the set
+func (s *AddressSet) Contains(a Address) bool {
+	if isNil(a) {
+		return false
+	}
+	_, ok := s.m[a.Key()]
+	return ok
+}
+
+// Len returns the number of addresses in the set
+func (s *AddressSet) Len() int {
+	return len(s.m)
+}
+
+// All returns an iterator over the addresses of the set, sorted according to Compare
+func (s *AddressSet) All() iter.Seq[Address] {
+	return func(yield func(Address) bool) {
+		addresses := make([]Address, 0, len(s.m))
+		for _, a := range s.m {
+			addresses = append(addresses, a)
+		}
+		slices.SortFunc(addresses, Compare)
+		for _, a := range addresses {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
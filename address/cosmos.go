@@ -2,11 +2,13 @@ package address
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 
 	"github.com/lombard-finance/ledger-utils/chainid"
 	"github.com/lombard-finance/ledger-utils/common"
+	"github.com/lombard-finance/ledger-utils/common/bech32"
 )
 
 // CosmWasmAddressLength is the length of a contract address in CosmWasm without prefix and checksum
@@ -19,11 +21,11 @@ const CosmosSdkAddressLength = 20
 const DifferenceWasmSdkLength = CosmWasmAddressLength - CosmosSdkAddressLength
 
 // ErrBadAddressCosmos is an ErrBadAddress specialized for Cosmos chains
-var ErrBadAddressCosmos = fmt.Errorf("evm %w", ErrBadAddress)
+var ErrBadAddressCosmos = fmt.Errorf("cosmos %w", ErrBadAddress)
 
 // CosmosAddress is the address type generic for Cosmos chains. It is NOT tied to a particular chain.
-// It includes very basic functionalities. Any additional property like verifying bech32 prefix
-// and checksum should be conducted with the Cosmos SDK.
+// It includes very basic functionalities. The bech32 prefix is not part of the address, so it has to be
+// provided by the caller when converting from and to the bech32 representation.
 type CosmosAddress struct {
 	inner []byte
 }
@@ -49,6 +51,28 @@ func NewCosmosAddress(addressBytes []byte) (*CosmosAddress, error) {
 	return &a, nil
 }
 
+// NewCosmosAddressFromBech32 creates a new CosmosAddress from its bech32 representation, verifying that
+// the human readable part matches the expected prefix
+func NewCosmosAddressFromBech32(address string, prefix string) (*CosmosAddress, error) {
+	hrp, decoded, err := bech32.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bech32 decoding error %w", ErrBadAddressCosmos, err)
+	}
+	if hrp != prefix {
+		return nil, fmt.Errorf("%w: bech32 prefix mismatch, given %s, expected %s", ErrBadAddressCosmos, hrp, prefix)
+	}
+	return NewCosmosAddress(decoded)
+}
+
+// Bech32 returns the bech32 representation of the address with the provided prefix
+func (c *CosmosAddress) Bech32(prefix string) (string, error) {
+	encoded, err := bech32.Encode(prefix, c.inner)
+	if err != nil {
+		return "", fmt.Errorf("%w: bech32 encoding error %w", ErrBadAddressCosmos, err)
+	}
+	return encoded, nil
+}
+
 // Bytes implements Address.
 func (c *CosmosAddress) Bytes() []byte {
 	buf := make([]byte, len(c.inner))
@@ -56,6 +80,16 @@ func (c *CosmosAddress) Bytes() []byte {
 	return buf
 }
 
+// Bytes32 implements Address. SDK addresses are left padded with zeroes.
+func (c *CosmosAddress) Bytes32() ([Bytes32Length]byte, error) {
+	return pad32(c.inner), nil
+}
+
+// Key returns the comparable representation of the address
+func (c *CosmosAddress) Key() AddressKey {
+	return newAddressKey(c.Ecosystem(), c.Bytes())
+}
+
 // Ecosystem implements Address.
 func (c *CosmosAddress) Ecosystem() chainid.Ecosystem {
 	return chainid.EcosystemCosmos
@@ -63,8 +97,8 @@ func (c *CosmosAddress) Ecosystem() chainid.Ecosystem {
 
 // Equal implements Address.
 func (c *CosmosAddress) Equal(a2 Address) bool {
-	if a2 == nil {
-		return false
+	if equal, ok := equalNil(c, a2); ok {
+		return equal
 	}
 	if c.Ecosystem() != a2.Ecosystem() {
 		return false
@@ -90,3 +124,59 @@ func (c *CosmosAddress) Length() int {
 func (c *CosmosAddress) String() string {
 	return "0x" + c.Hex()
 }
+
+// NewCosmosModuleAddress returns the address of a module account following ADR-028. Without derivation keys the
+// legacy module address `sha256(name)[:20]` is returned (e.g. `bank`, `gov`), otherwise the 32 bytes address of
+// `Module(name, derivationKeys...)` is returned.
+func NewCosmosModuleAddress(name string, derivationKeys ...[]byte) *CosmosAddress {
+	return &CosmosAddress{inner: adr028Module(name, derivationKeys...)}
+}
+
+// NewCosmosDerivedAddress returns the 32 bytes address of the sub-account derived from parent with the given key,
+// following ADR-028 `Derive(parent, key)`. An error is returned if parent is missing.
+func NewCosmosDerivedAddress(parent *CosmosAddress, key []byte) (*CosmosAddress, error) {
+	if parent == nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadAddressCosmos, ErrEmptyAddress)
+	}
+	return &CosmosAddress{inner: adr028Derive(parent.inner, key)}, nil
+}
+
+// NewCosmosHashAddress returns the 32 bytes address computed with the ADR-028 base construction `Hash(typ, key)`,
+// i.e. `sha256(sha256(typ) || key)`.
+func NewCosmosHashAddress(typ string, key []byte) *CosmosAddress {
+	return &CosmosAddress{inner: adr028Hash(typ, key)}
+}
+
+// adr028Hash implements the ADR-028 base construction `sha256(sha256(typ) || key)`
+func adr028Hash(typ string, key []byte) []byte {
+	th := sha256.Sum256([]byte(typ))
+	hasher := sha256.New()
+	hasher.Write(th[:])
+	hasher.Write(key)
+	return hasher.Sum(nil)
+}
+
+// adr028Module implements the ADR-028 module account derivation. Without derivation keys it falls back to the
+// legacy module address `sha256(name)[:20]`, otherwise the first key is hashed together with the module name and
+// any further key derives a sub-account from the previous result.
+func adr028Module(name string, derivationKeys ...[]byte) []byte {
+	if len(derivationKeys) == 0 {
+		h := sha256.Sum256([]byte(name))
+		return h[:CosmosSdkAddressLength]
+	}
+	// a zero byte separates module name and first key to avoid clashes between the two
+	moduleKey := make([]byte, 0, len(name)+1+len(derivationKeys[0]))
+	moduleKey = append(moduleKey, name...)
+	moduleKey = append(moduleKey, 0)
+	moduleKey = append(moduleKey, derivationKeys[0]...)
+	addr := adr028Hash("module", moduleKey)
+	for _, k := range derivationKeys[1:] {
+		addr = adr028Derive(addr, k)
+	}
+	return addr
+}
+
+// adr028Derive implements the ADR-028 derivation of a sub-account address from a parent address
+func adr028Derive(parent []byte, key []byte) []byte {
+	return adr028Hash(string(parent), key)
+}
@@ -0,0 +1,62 @@
+package address
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// CosmWasmModuleName is the name of the wasm module, used as module account name when deriving contract addresses
+const CosmWasmModuleName = "wasm"
+
+// CosmWasmChecksumLength is the length of the sha256 checksum of a wasm code
+const CosmWasmChecksumLength = 32
+
+// CosmWasmMaxSaltLength is the maximum length of the salt accepted by instantiate2
+const CosmWasmMaxSaltLength = 64
+
+// ErrBadCosmWasmDerivation is returned when the inputs of a CosmWasm contract address derivation are invalid
+var ErrBadCosmWasmDerivation = fmt.Errorf("%w: invalid cosmwasm contract address derivation", ErrBadAddressCosmos)
+
+// NewCosmWasmClassicAddress derives the address of a contract instantiated with the classic `instantiate`
+// message, given the code id and the global instance id (the contract sequence of the wasm module).
+// The address is computed as ADR-028 `Module("wasm", codeID || instanceID)` with both ids encoded as big endian u64.
+func NewCosmWasmClassicAddress(codeId uint64, instanceId uint64) *CosmosAddress {
+	contractId := make([]byte, 16)
+	binary.BigEndian.PutUint64(contractId[:8], codeId)
+	binary.BigEndian.PutUint64(contractId[8:], instanceId)
+	return &CosmosAddress{inner: adr028Module(CosmWasmModuleName, contractId)[:CosmWasmAddressLength]}
+}
+
+// NewCosmWasmInstantiate2Address derives the predictable address of a contract instantiated with `instantiate2`.
+// The address is computed as ADR-028 `Module("wasm", key)` where key is the concatenation of checksum, creator,
+// salt and msg, each prefixed by its length as big endian u64. The msg is optional and it is included in the
+// derivation only when the chain enables `fix_msg`, otherwise nil should be passed.
+func NewCosmWasmInstantiate2Address(checksum []byte, creator *CosmosAddress, salt []byte, msg []byte) (*CosmosAddress, error) {
+	if len(checksum) != CosmWasmChecksumLength {
+		return nil, fmt.Errorf(
+			"%w: checksum length error, given %d, expected %d",
+			ErrBadCosmWasmDerivation, len(checksum), CosmWasmChecksumLength,
+		)
+	}
+	if creator == nil {
+		return nil, fmt.Errorf("%w: creator is missing", ErrBadCosmWasmDerivation)
+	}
+	if len(salt) == 0 || len(salt) > CosmWasmMaxSaltLength {
+		return nil, fmt.Errorf(
+			"%w: salt length error, given %d, expected between 1 and %d",
+			ErrBadCosmWasmDerivation, len(salt), CosmWasmMaxSaltLength,
+		)
+	}
+	key := make([]byte, 0, 4*8+len(checksum)+len(creator.inner)+len(salt)+len(msg))
+	key = appendLengthPrefixed(key, checksum)
+	key = appendLengthPrefixed(key, creator.inner)
+	key = appendLengthPrefixed(key, salt)
+	key = appendLengthPrefixed(key, msg)
+	return &CosmosAddress{inner: adr028Module(CosmWasmModuleName, key)[:CosmWasmAddressLength]}, nil
+}
+
+// appendLengthPrefixed appends b to dst, prefixed by its length encoded as big endian u64
+func appendLengthPrefixed(dst []byte, b []byte) []byte {
+	dst = binary.BigEndian.AppendUint64(dst, uint64(len(b)))
+	return append(dst, b...)
+}
@@ -0,0 +1,47 @@
+package address_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/address"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestCosmWasmClassicAddress(t *testing.T) {
+	// first contract on wasmd based chains, e.g. cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+	addr := address.NewCosmWasmClassicAddress(1, 1)
+	common.EqualStrings(t, "ade4a5f5803a439835c636395a8d648dee57b2fc90d98dc17fa887159b69638b", addr.Hex())
+	common.AssertTrue(t, address.CosmWasmAddressLength == addr.Length())
+
+	other := address.NewCosmWasmClassicAddress(1, 2)
+	common.AssertFalse(t, addr.Equal(other))
+}
+
+func TestCosmWasmInstantiate2Address(t *testing.T) {
+	checksum, _ := hex.DecodeString("13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5")
+	creatorBytes, _ := hex.DecodeString("9999999999aaaaaaaaaabbbbbbbbbbcccccccccc")
+	creator, err := address.NewCosmosAddress(creatorBytes)
+	common.AssertNoError(t, err)
+
+	t.Run("should match cosmwasm vectors", func(t *testing.T) {
+		addr, err := address.NewCosmWasmInstantiate2Address(checksum, creator, []byte("a"), nil)
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "5e865d3e45ad3e961f77fd77d46543417ced44d924dc3e079b5415ff6775f847", addr.Hex())
+
+		addr, err = address.NewCosmWasmInstantiate2Address(checksum, creator, []byte("a"), []byte("{}"))
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "0995499608947a5281e2c7ebd71bdb26a1ad981946dad57f6c4d3ee35de77835", addr.Hex())
+	})
+
+	t.Run("should reject invalid inputs", func(t *testing.T) {
+		_, err := address.NewCosmWasmInstantiate2Address(checksum[1:], creator, []byte("a"), nil)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadCosmWasmDerivation)
+		_, err = address.NewCosmWasmInstantiate2Address(checksum, nil, []byte("a"), nil)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadCosmWasmDerivation)
+		_, err = address.NewCosmWasmInstantiate2Address(checksum, creator, nil, nil)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadCosmWasmDerivation)
+		_, err = address.NewCosmWasmInstantiate2Address(checksum, creator, make([]byte, 65), nil)
+		common.AssertError(t, err, address.ErrBadAddress, address.ErrBadCosmWasmDerivation)
+	})
+}
@@ -0,0 +1,200 @@
+package address
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/bech32"
+)
+
+// Confidence is a hint on how likely a Candidate is the ecosystem the address string belongs to
+type Confidence byte
+
+const (
+	// ConfidenceLow is given to formats accepted by the ecosystem but not commonly used to represent its
+	// addresses, e.g. the hex encoding of a Cosmos address
+	ConfidenceLow Confidence = iota
+	// ConfidenceMedium is given to formats shared by several ecosystems or lacking any checksum, e.g. 64 hex chars
+	// or the base58 encoding of 32 bytes
+	ConfidenceMedium
+	// ConfidenceHigh is given to formats specific to the ecosystem, usually prefixed or checksummed, e.g. SS58 or
+	// bech32 with a known prefix
+	ConfidenceHigh
+)
+
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceLow:
+		return "low"
+	case ConfidenceMedium:
+		return "medium"
+	case ConfidenceHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("confidence %d", c)
+	}
+}
+
+// Candidate is an ecosystem whose address format accepts a given string
+type Candidate struct {
+	// Ecosystem is the ecosystem the address may belong to
+	Ecosystem chainid.Ecosystem
+	// Address is the address parsed according to the ecosystem
+	Address Address
+	// Confidence is a hint on how likely the address belongs to the ecosystem
+	Confidence Confidence
+	// Network is the name of the network identified by the format, if any, e.g. `litecoin` or `osmosis`
+	Network string
+}
+
+// hexNativeLengths are the lengths in bytes of the hex strings natively used to represent addresses in each
+// ecosystem. Hex strings of other lengths, or in other ecosystems, are accepted by the parsers but are not the
+// usual representation of addresses.
+var hexNativeLengths = map[chainid.Ecosystem][]int{
+	chainid.EcosystemEVM:      {EvmAddressLength},
+	chainid.EcosystemSui:      {SuiAddressLength},
+	chainid.EcosystemCosmos:   {CosmWasmAddressLength}, // CosmWasm contract addresses
+	chainid.EcosystemStarknet: {StarknetAddressLength},
+	chainid.EcosystemAptos:    {AptosAddressLength, 1}, // special addresses admit the short form
+	chainid.EcosystemTron:     {TronAddressLength + 1},
+}
+
+// DetectCandidates returns all the ecosystems whose address format accepts the given string, sorted by decreasing
+// confidence and then by ecosystem. Addresses are parsed with the same constructors used by NewAddressFromString,
+// so that detection never disagrees with parsing, and additionally:
+//   - bech32 strings are tried as Cosmos addresses, identifying the chain when the prefix is known
+//   - base58check and segwit strings are tried as addresses of the known UTXO networks
+//
+// Ecosystems without a specialized address type are never returned, since they accept any hex string.
+func DetectCandidates(s string) []Candidate {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	var out []Candidate
+	trimmed := strings.TrimPrefix(s, "0x")
+	isHex := isHexString(trimmed)
+	// odd lengths are accepted by the Aptos short form only
+	hexLength := (len(trimmed) + 1) / 2
+	nativeHex := 0
+	for _, e := range codecEcosystems() {
+		a, err := NewAddressFromString(s, e)
+		if err != nil {
+			continue
+		}
+		c := Candidate{Ecosystem: e, Address: a, Confidence: ConfidenceHigh}
+		switch {
+		case isHex && isHexNative(e, hexLength):
+			// ambiguity among native hex formats is solved below
+			nativeHex++
+		case isHex:
+			c.Confidence = ConfidenceLow
+		case e == chainid.EcosystemNear:
+			c.Confidence = nearConfidence(a.(*NearAddress))
+		case e == chainid.EcosystemSolana:
+			// base58 without checksum
+			c.Confidence = ConfidenceMedium
+		}
+		out = append(out, c)
+	}
+	if nativeHex > 1 {
+		for i := range out {
+			if out[i].Confidence == ConfidenceHigh {
+				out[i].Confidence = ConfidenceMedium
+			}
+		}
+	}
+	out = append(out, detectUtxoCandidates(s)...)
+	out = append(out, detectCosmosCandidates(s)...)
+	slices.SortStableFunc(out, func(a, b Candidate) int {
+		if a.Confidence != b.Confidence {
+			return cmp.Compare(b.Confidence, a.Confidence)
+		}
+		return cmp.Compare(a.Ecosystem, b.Ecosystem)
+	})
+	return out
+}
+
+func isHexString(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// isHexNative reports whether a hex string of the given length in bytes is a native representation of the
+// addresses of the ecosystem
+func isHexNative(e chainid.Ecosystem, length int) bool {
+	return slices.Contains(hexNativeLengths[e], length)
+}
+
+// nearConfidence returns the confidence of a NEAR candidate, since most lowercase strings are valid named accounts
+func nearConfidence(a *NearAddress) Confidence {
+	if a.Kind() != NearNamedAccount {
+		// hex based accounts are detected as hex
+		return ConfidenceLow
+	}
+	for _, topLevel := range nearTopLevelAccounts {
+		if strings.HasSuffix(a.AccountId(), "."+topLevel) {
+			return ConfidenceHigh
+		}
+	}
+	return ConfidenceLow
+}
+
+func detectUtxoCandidates(s string) []Candidate {
+	var out []Candidate
+	for _, params := range chainid.KnownUtxoNetworkParams() {
+		a, err := NewUtxoAddressFromString(s, params)
+		if err != nil {
+			continue
+		}
+		out = append(out, Candidate{
+			Ecosystem:  chainid.EcosystemBitcoin,
+			Address:    a,
+			Confidence: ConfidenceHigh,
+			Network:    params.Name,
+		})
+	}
+	return out
+}
+
+func detectCosmosCandidates(s string) []Candidate {
+	hrp, _, err := bech32.Decode(s)
+	if err != nil {
+		return nil
+	}
+	for _, params := range chainid.KnownUtxoNetworkParams() {
+		if params.Bech32Prefix != "" && hrp == params.Bech32Prefix {
+			return nil
+		}
+	}
+	a, err := NewCosmosAddressFromBech32(s, hrp)
+	if err != nil {
+		return nil
+	}
+	var chains []string
+	for _, info := range chainid.KnownCosmosChains() {
+		if info.Bech32Prefix == hrp {
+			chains = append(chains, info.ChainName)
+		}
+	}
+	c := Candidate{Ecosystem: chainid.EcosystemCosmos, Address: a, Confidence: ConfidenceMedium}
+	if len(chains) > 0 {
+		c.Confidence = ConfidenceHigh
+	}
+	// the network is identified only when a single known chain uses the prefix
+	if len(chains) == 1 {
+		c.Network = chains[0]
+	}
+	return []Candidate{c}
+}
@@ -0,0 +1,63 @@
+package address
+
+import (
+	"fmt"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+)
+
+// ErrNotEvmConvertible is returned when an address cannot be shared between the EVM and the Cosmos representation
+var ErrNotEvmConvertible = fmt.Errorf("%w: not convertible between evm and cosmos", ErrBadAddress)
+
+// EvmToCosmosAddress returns the CosmosAddress carrying the same 20 bytes of the EvmAddress, as it happens on
+// Ethermint-style chains.
+func EvmToCosmosAddress(a *EvmAddress) *CosmosAddress {
+	return &CosmosAddress{inner: a.Bytes()}
+}
+
+// CosmosToEvmAddress returns the EvmAddress carrying the same 20 bytes of the CosmosAddress, as it happens on
+// Ethermint-style chains. CosmWasm 32 bytes addresses have no EVM counterpart and are rejected.
+func CosmosToEvmAddress(c *CosmosAddress) (*EvmAddress, error) {
+	if c.Length() != CosmosSdkAddressLength {
+		return nil, fmt.Errorf(
+			"%w: length error, given %d, expected %d",
+			ErrNotEvmConvertible, c.Length(), CosmosSdkAddressLength,
+		)
+	}
+	return NewEvmAddress(c.inner)
+}
+
+// EvmToCosmosBech32 returns the bech32 representation of the EvmAddress on the given Cosmos chain. The chain
+// must be known and EVM compatible, so that its bech32 prefix is available.
+func EvmToCosmosBech32(a *EvmAddress, id chainid.CosmosLChainId) (string, error) {
+	info, err := evmCompatibleCosmosChainInfo(id)
+	if err != nil {
+		return "", err
+	}
+	return EvmToCosmosAddress(a).Bech32(info.Bech32Prefix)
+}
+
+// NewEvmAddressFromCosmosBech32 creates a new EvmAddress from the bech32 representation of an account on the
+// given Cosmos chain. The chain must be known and EVM compatible, and the prefix must match the chain one.
+func NewEvmAddressFromCosmosBech32(address string, id chainid.CosmosLChainId) (*EvmAddress, error) {
+	info, err := evmCompatibleCosmosChainInfo(id)
+	if err != nil {
+		return nil, err
+	}
+	c, err := NewCosmosAddressFromBech32(address, info.Bech32Prefix)
+	if err != nil {
+		return nil, err
+	}
+	return CosmosToEvmAddress(c)
+}
+
+func evmCompatibleCosmosChainInfo(id chainid.CosmosLChainId) (chainid.CosmosChainInfo, error) {
+	info, ok := id.Info()
+	if !ok {
+		return chainid.CosmosChainInfo{}, fmt.Errorf("%w: unknown cosmos chain %s", ErrNotEvmConvertible, id.String())
+	}
+	if !info.EvmCompatible {
+		return chainid.CosmosChainInfo{}, fmt.Errorf("%w: cosmos chain %s is not evm compatible", ErrNotEvmConvertible, info.ChainName)
+	}
+	return info, nil
+}
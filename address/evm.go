@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/keccak"
 )
 
 const EvmAddressLength = 20
@@ -14,6 +15,9 @@ const EvmAddressLength = 20
 // ErrBadAddressEvm is an ErrBadAddress specialized for EVM chains
 var ErrBadAddressEvm = fmt.Errorf("evm %w", ErrBadAddress)
 
+// ErrBadChecksumEvm is returned when an EVM address is not encoded with its EIP-55 checksum
+var ErrBadChecksumEvm = fmt.Errorf("%w: invalid eip-55 checksum", ErrBadAddressEvm)
+
 // EvmAddress is the address type for EVM chains
 type EvmAddress struct {
 	inner [EvmAddressLength]byte
@@ -35,7 +39,7 @@ func NewEvmAddress(b []byte) (*EvmAddress, error) {
 }
 
 // NewEvmAddressFromHex creates a new EvmAddress from an hex string. Both string with
-// and without leading 0x are supported
+// and without leading 0x are supported. The case is not enforced, see NewEvmAddressFromChecksumHex.
 func NewEvmAddressFromHex(address string) (*EvmAddress, error) {
 	decoded, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
 	if err != nil {
@@ -44,6 +48,19 @@ func NewEvmAddressFromHex(address string) (*EvmAddress, error) {
 	return NewEvmAddress(decoded)
 }
 
+// NewEvmAddressFromChecksumHex creates a new EvmAddress from its EIP-55 checksummed hex string, with
+// optional leading 0x. An ErrBadChecksumEvm is returned if the case of the string does not match the checksum.
+func NewEvmAddressFromChecksumHex(address string) (*EvmAddress, error) {
+	a, err := NewEvmAddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimPrefix(address, "0x") != a.checksumHex() {
+		return nil, fmt.Errorf("%w: given %s, expected %s", ErrBadChecksumEvm, address, a.ChecksumString())
+	}
+	return a, nil
+}
+
 func (a *EvmAddress) String() string {
 	return "0x" + a.Hex()
 }
@@ -52,12 +69,44 @@ func (a *EvmAddress) Hex() string {
 	return hex.EncodeToString(a.inner[:])
 }
 
+// ChecksumString returns the EIP-55 checksummed hex encoding of the address with leading 0x
+func (a *EvmAddress) ChecksumString() string {
+	return "0x" + a.checksumHex()
+}
+
+// checksumHex upper cases the letters of the hex encoding whose nibble in the keccak256 of the lowercase hex is
+// at least 8, as defined by EIP-55
+func (a *EvmAddress) checksumHex() string {
+	out := []byte(a.Hex())
+	digest := keccak.Sum256(out)
+	for i, c := range out {
+		nibble := digest[i/2] >> 4
+		if i%2 == 1 {
+			nibble = digest[i/2] & 0x0f
+		}
+		if c >= 'a' && nibble >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return string(out)
+}
+
 func (a *EvmAddress) Bytes() []byte {
 	buf := make([]byte, EvmAddressLength)
 	copy(buf, a.inner[:])
 	return buf
 }
 
+// Bytes32 returns the address left padded with zeroes
+func (a *EvmAddress) Bytes32() ([Bytes32Length]byte, error) {
+	return pad32(a.inner[:]), nil
+}
+
+// Key returns the comparable representation of the address
+func (a *EvmAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
 func (a *EvmAddress) Length() int {
 	return EvmAddressLength
 }
@@ -67,6 +116,9 @@ func (a *EvmAddress) Ecosystem() chainid.Ecosystem {
 }
 
 func (a1 *EvmAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
 	if a2.Ecosystem() != a1.Ecosystem() {
 		return false
 	}
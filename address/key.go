@@ -0,0 +1,66 @@
+package address
+
+import (
+	"crypto/sha256"
+	"reflect"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+)
+
+// AddressKeyDataLength is the number of bytes of an address stored as-is in an AddressKey. It fits the addresses of
+// all the supported ecosystems, the longest being NEAR named accounts.
+const AddressKeyDataLength = 64
+
+// AddressKey is a comparable representation of an Address, made of its ecosystem and bytes, so that it can be used
+// as map key or compared with ==. Addresses of the same type have the same key if and only if they are Equal. Bytes
+// longer than AddressKeyDataLength are replaced by their sha256 digest, so that keys are not meant to be decoded.
+type AddressKey struct {
+	ecosystem chainid.Ecosystem
+	length    int
+	data      [AddressKeyDataLength]byte
+}
+
+func newAddressKey(e chainid.Ecosystem, b []byte) AddressKey {
+	k := AddressKey{ecosystem: e, length: len(b)}
+	if len(b) > AddressKeyDataLength {
+		digest := sha256.Sum256(b)
+		copy(k.data[:], digest[:])
+		return k
+	}
+	copy(k.data[:], b)
+	return k
+}
+
+// Ecosystem returns the ecosystem of the address
+func (k AddressKey) Ecosystem() chainid.Ecosystem {
+	return k.ecosystem
+}
+
+// Length returns the length of the address in bytes
+func (k AddressKey) Length() int {
+	return k.length
+}
+
+// IsZero reports whether k is the key of no address
+func (k AddressKey) IsZero() bool {
+	return k == AddressKey{}
+}
+
+// isNil reports whether a is nil, including nil pointers wrapped in the interface
+func isNil(a Address) bool {
+	if a == nil {
+		return true
+	}
+	v := reflect.ValueOf(a)
+	return v.Kind() == reflect.Pointer && v.IsNil()
+}
+
+// equalNil compares a1 and a2 when any of them is nil, reporting the result and whether it applies. Nil addresses
+// are only equal to each other.
+func equalNil(a1, a2 Address) (equal bool, ok bool) {
+	nil1, nil2 := isNil(a1), isNil(a2)
+	if !nil1 && !nil2 {
+		return false, false
+	}
+	return nil1 && nil2, true
+}
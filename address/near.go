@@ -0,0 +1,222 @@
+package address
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+)
+
+const (
+	// NearMinAccountIdLength is the minimum length of a NEAR account id
+	NearMinAccountIdLength = 2
+	// NearMaxAccountIdLength is the maximum length of a NEAR account id
+	NearMaxAccountIdLength = 64
+	// NearImplicitAccountIdLength is the length of implicit account ids, i.e. the hex encoded ed25519 public key
+	NearImplicitAccountIdLength = 64
+	// NearEthImplicitAccountIdLength is the length of ETH-implicit account ids, i.e. the 0x led EVM address
+	NearEthImplicitAccountIdLength = 2 + EvmAddressLength*2
+)
+
+// NearAccountKind identifies the kind of a NEAR account according to its account id
+type NearAccountKind byte
+
+const (
+	// NearNamedAccount is a human-readable account, e.g. `lombard.near`
+	NearNamedAccount NearAccountKind = iota
+	// NearImplicitAccount is an account whose id is the hex encoding of an ed25519 public key
+	NearImplicitAccount
+	// NearEthImplicitAccount is an account whose id is the lowercase 0x led hex encoding of an EVM address
+	NearEthImplicitAccount
+)
+
+func (k NearAccountKind) String() string {
+	switch k {
+	case NearNamedAccount:
+		return "named"
+	case NearImplicitAccount:
+		return "implicit"
+	case NearEthImplicitAccount:
+		return "eth-implicit"
+	default:
+		return fmt.Sprintf("kind %d", k)
+	}
+}
+
+// ErrBadAddressNear is an ErrBadAddress specialized for NEAR
+var ErrBadAddressNear = fmt.Errorf("near %w", ErrBadAddress)
+
+// NearAddress is the address type for the NEAR blockchain. Its canonical form is the account id string, so that
+// Bytes are the UTF-8 encoding of the account id for all kinds of account, and the length of the address varies
+// between 2 and 64 bytes. In particular Bytes of implicit accounts are the 64 hex chars and not the public key.
+type NearAddress struct {
+	accountId string
+	kind      NearAccountKind
+}
+
+// NewNearAddress creates a new NearAddress from the UTF-8 bytes of the account id
+func NewNearAddress(b []byte) (*NearAddress, error) {
+	return NewNearAddressFromString(string(b))
+}
+
+// NewNearAddressFromString creates a new NearAddress from the account id, validating it against the NEAR
+// account id grammar: lowercase alphanumeric parts separated by a single `-`, `_` or `.`, for a total of
+// 2 to 64 chars.
+func NewNearAddressFromString(accountId string) (*NearAddress, error) {
+	if err := validateNearAccountId(accountId); err != nil {
+		return nil, err
+	}
+	return &NearAddress{accountId: accountId, kind: nearAccountKind(accountId)}, nil
+}
+
+// NewNearImplicitAddress creates the NearAddress of the implicit account of the given ed25519 public key
+func NewNearImplicitAddress(publicKey []byte) (*NearAddress, error) {
+	if len(publicKey) != NearImplicitAccountIdLength/2 {
+		return nil, fmt.Errorf(
+			"%w: public key length error, given %d, expected %d",
+			ErrBadAddressNear, len(publicKey), NearImplicitAccountIdLength/2,
+		)
+	}
+	return &NearAddress{accountId: hex.EncodeToString(publicKey), kind: NearImplicitAccount}, nil
+}
+
+// NewNearEthImplicitAddress creates the NearAddress of the ETH-implicit account of the given EVM address
+func NewNearEthImplicitAddress(a *EvmAddress) *NearAddress {
+	return &NearAddress{accountId: "0x" + a.Hex(), kind: NearEthImplicitAccount}
+}
+
+func validateNearAccountId(accountId string) error {
+	if len(accountId) < NearMinAccountIdLength || len(accountId) > NearMaxAccountIdLength {
+		return fmt.Errorf(
+			"%w: length error, given %d, expected between %d and %d",
+			ErrBadAddressNear, len(accountId), NearMinAccountIdLength, NearMaxAccountIdLength,
+		)
+	}
+	// account ids cannot start with a separator
+	afterSeparator := true
+	for i := 0; i < len(accountId); i++ {
+		c := accountId[i]
+		switch {
+		case 'a' <= c && c <= 'z', '0' <= c && c <= '9':
+			afterSeparator = false
+		case c == '-', c == '_', c == '.':
+			if afterSeparator {
+				return fmt.Errorf("%w: unexpected separator %q at position %d", ErrBadAddressNear, c, i)
+			}
+			afterSeparator = true
+		default:
+			return fmt.Errorf("%w: invalid char %q at position %d", ErrBadAddressNear, c, i)
+		}
+	}
+	if afterSeparator {
+		return fmt.Errorf("%w: account id cannot end with a separator", ErrBadAddressNear)
+	}
+	return nil
+}
+
+// nearAccountKind returns the kind of a valid account id
+func nearAccountKind(accountId string) NearAccountKind {
+	switch {
+	case len(accountId) == NearImplicitAccountIdLength && isLowerHex(accountId):
+		return NearImplicitAccount
+	case len(accountId) == NearEthImplicitAccountIdLength && strings.HasPrefix(accountId, "0x") &&
+		isLowerHex(accountId[2:]):
+		return NearEthImplicitAccount
+	default:
+		return NearNamedAccount
+	}
+}
+
+func isLowerHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !('0' <= s[i] && s[i] <= '9' || 'a' <= s[i] && s[i] <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// Kind returns the kind of the account
+func (a *NearAddress) Kind() NearAccountKind {
+	return a.kind
+}
+
+// AccountId returns the account id of the address
+func (a *NearAddress) AccountId() string {
+	return a.accountId
+}
+
+// PublicKey returns the ed25519 public key of implicit accounts and whether the account is implicit
+func (a *NearAddress) PublicKey() ([]byte, bool) {
+	if a.kind != NearImplicitAccount {
+		return nil, false
+	}
+	// account id is known to be valid hex
+	b, _ := hex.DecodeString(a.accountId)
+	return b, true
+}
+
+// EvmAddress returns the EVM address of ETH-implicit accounts and whether the account is ETH-implicit
+func (a *NearAddress) EvmAddress() (*EvmAddress, bool) {
+	if a.kind != NearEthImplicitAccount {
+		return nil, false
+	}
+	evm, err := NewEvmAddressFromHex(a.accountId)
+	if err != nil {
+		return nil, false
+	}
+	return evm, true
+}
+
+// String returns the account id
+func (a *NearAddress) String() string {
+	return a.accountId
+}
+
+// Hex returns the hex encoding of the UTF-8 bytes of the account id
+func (a *NearAddress) Hex() string {
+	return hex.EncodeToString(a.Bytes())
+}
+
+// Bytes returns the UTF-8 bytes of the account id
+func (a *NearAddress) Bytes() []byte {
+	return []byte(a.accountId)
+}
+
+// Bytes32 returns the public key of implicit accounts. Named and ETH-implicit accounts are not representable, since
+// public keys take all the 32 bytes and no marker can tell the kinds apart.
+func (a *NearAddress) Bytes32() ([Bytes32Length]byte, error) {
+	pk, ok := a.PublicKey()
+	if !ok {
+		return [Bytes32Length]byte{}, fmt.Errorf("%w: near %s account", ErrNotRepresentableBytes32, a.kind)
+	}
+	return [Bytes32Length]byte(pk), nil
+}
+
+// Key returns the comparable representation of the address
+func (a *NearAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *NearAddress) Length() int {
+	return len(a.accountId)
+}
+
+func (a *NearAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemNear
+}
+
+func (a1 *NearAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsNear, ok := a2.(*NearAddress)
+	if !ok {
+		return false
+	}
+	return a1.accountId == a2AsNear.accountId
+}
@@ -0,0 +1,173 @@
+package address
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+// Codec describes how the addresses of an ecosystem are created, so that ecosystems not built into the library
+// can be plugged in by downstream modules through RegisterCodec.
+type Codec struct {
+	// FromBytes creates an address from its bytes, used by NewAddress. It is mandatory.
+	FromBytes func(b []byte) (Address, error)
+	// FromString creates an address from its string representation, used by NewAddressFromString. When nil, the
+	// string is decoded as hex with optional '0x'.
+	FromString func(s string) (Address, error)
+	// Zero returns the zero address of the ecosystem, used by NewZeroAddress. When nil, the address is created
+	// from 32 zero bytes.
+	Zero func() Address
+	// FromBytes32 creates an address from its canonical 32 bytes representation, used by NewAddressFromBytes32.
+	// When nil, FromBytes is called with the 32 bytes.
+	FromBytes32 func(b [Bytes32Length]byte) (Address, error)
+}
+
+var ErrCodecAlreadyRegistered = fmt.Errorf("address codec already registered")
+var ErrInvalidCodec = fmt.Errorf("invalid address codec")
+
+// codecs is the registry of the address codecs, pre-populated with the built-in ones. Ecosystems without a codec
+// use GenericAddress.
+var codecs = struct {
+	sync.RWMutex
+	byEcosystem map[chainid.Ecosystem]Codec
+}{
+	byEcosystem: map[chainid.Ecosystem]Codec{
+		chainid.EcosystemEVM: {
+			FromBytes: bytesCodec(NewEvmAddress),
+			Zero: func() Address {
+				addr, _ := NewEvmAddress(common.Bytes32Zeros[:EvmAddressLength])
+				return addr
+			},
+			FromBytes32: newEvmAddressFromBytes32,
+		},
+		chainid.EcosystemSui: {
+			FromBytes: bytesCodec(NewSuiAddress),
+		},
+		chainid.EcosystemSolana: {
+			FromBytes:  bytesCodec(NewSolanaAddress),
+			FromString: stringCodec(NewSolanaAddressFromBase58),
+		},
+		chainid.EcosystemCosmos: {
+			FromBytes: bytesCodec(NewCosmosAddress),
+		},
+		chainid.EcosystemStarknet: {
+			FromBytes: bytesCodec(NewStarknetAddress),
+		},
+		chainid.EcosystemAptos: {
+			FromBytes:  bytesCodec(NewAptosAddress),
+			FromString: stringCodec(NewAptosAddressFromHex),
+		},
+		chainid.EcosystemTon: {
+			FromBytes:  bytesCodec(NewTonAddress),
+			FromString: stringCodec(NewTonAddressFromString),
+		},
+		chainid.EcosystemTron: {
+			FromBytes:   bytesCodec(NewTronAddress),
+			FromString:  stringCodec(NewTronAddressFromString),
+			FromBytes32: newTronAddressFromBytes32,
+		},
+		chainid.EcosystemStellar: {
+			FromBytes:  bytesCodec(NewStellarAddress),
+			FromString: stringCodec(NewStellarAddressFromStrKey),
+		},
+		chainid.EcosystemXrpl: {
+			FromBytes:  bytesCodec(NewXrplAddress),
+			FromString: stringCodec(NewXrplAddressFromString),
+			Zero: func() Address {
+				addr, _ := NewXrplAddress(common.Bytes32Zeros[:XrplAccountIdLength])
+				return addr
+			},
+			FromBytes32: newXrplAddressFromBytes32,
+		},
+		chainid.EcosystemSubstrate: {
+			FromBytes:  bytesCodec(NewSS58Address),
+			FromString: stringCodec(NewSS58AddressFromString),
+		},
+		chainid.EcosystemNear: {
+			FromBytes:  bytesCodec(NewNearAddress),
+			FromString: stringCodec(NewNearAddressFromString),
+			Zero: func() Address {
+				addr, _ := NewNearImplicitAddress(common.Bytes32Zeros)
+				return addr
+			},
+			FromBytes32: newNearAddressFromBytes32,
+		},
+		chainid.EcosystemCardano: {
+			FromBytes:  bytesCodec(NewCardanoAddress),
+			FromString: stringCodec(NewCardanoAddressFromBech32),
+			Zero: func() Address {
+				// enterprise address of the zero key hash on mainnet
+				header := byte(CardanoEnterpriseKey)<<4 | chainid.CardanoMainnetNetworkId
+				addr, _ := NewCardanoAddress(append([]byte{header}, common.Bytes32Zeros[:CardanoHashLength]...))
+				return addr
+			},
+			FromBytes32: newCardanoAddressFromBytes32,
+		},
+		chainid.EcosystemAlgorand: {
+			FromBytes:  bytesCodec(NewAlgorandAddress),
+			FromString: stringCodec(NewAlgorandAddressFromBase32),
+		},
+	},
+}
+
+// bytesCodec adapts a typed constructor from bytes to Codec.FromBytes, so that errors are returned along with a
+// nil Address rather than a typed nil pointer
+func bytesCodec[A Address](newAddress func([]byte) (A, error)) func([]byte) (Address, error) {
+	return func(b []byte) (Address, error) {
+		a, err := newAddress(b)
+		if err != nil {
+			return nil, err
+		}
+		return a, nil
+	}
+}
+
+// stringCodec adapts a typed constructor from string to Codec.FromString, so that errors are returned along with a
+// nil Address rather than a typed nil pointer
+func stringCodec[A Address](newAddress func(string) (A, error)) func(string) (Address, error) {
+	return func(s string) (Address, error) {
+		a, err := newAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		return a, nil
+	}
+}
+
+// RegisterCodec registers the codec used to create the addresses of the given ecosystem. It fails if a codec is
+// already registered for the ecosystem, either as built-in or by a previous call. It is safe for concurrent use.
+func RegisterCodec(e chainid.Ecosystem, codec Codec) error {
+	if codec.FromBytes == nil {
+		return fmt.Errorf("%w: missing FromBytes for %s", ErrInvalidCodec, e)
+	}
+	codecs.Lock()
+	defer codecs.Unlock()
+	if _, ok := codecs.byEcosystem[e]; ok {
+		return fmt.Errorf("%w: %s", ErrCodecAlreadyRegistered, e)
+	}
+	codecs.byEcosystem[e] = codec
+	return nil
+}
+
+// LookupCodec returns the codec of the ecosystem and whether one is registered
+func LookupCodec(e chainid.Ecosystem) (Codec, bool) {
+	codecs.RLock()
+	defer codecs.RUnlock()
+	codec, ok := codecs.byEcosystem[e]
+	return codec, ok
+}
+
+// codecEcosystems returns the ecosystems with a registered codec, sorted by ecosystem byte
+func codecEcosystems() []chainid.Ecosystem {
+	codecs.RLock()
+	defer codecs.RUnlock()
+	out := make([]chainid.Ecosystem, 0, len(codecs.byEcosystem))
+	for e := range codecs.byEcosystem {
+		out = append(out, e)
+	}
+	slices.Sort(out)
+	return out
+}
@@ -40,13 +40,18 @@ func NewSolanaAddressFromHex(address string) (*SolanaAddress, error) {
 	return NewSolanaAddress(b)
 }
 
-// NewSolanaAddressFromBase58 creates a new SolanaAddress from a base58 string.
+// NewSolanaAddressFromBase58 creates a new SolanaAddress from a base58 string. Strings longer than the
+// maximum base58 length of an address are rejected without decoding them.
 func NewSolanaAddressFromBase58(address string) (*SolanaAddress, error) {
-	b, err := base58.Decode(address)
+	a := &SolanaAddress{}
+	n, err := base58.DecodeInto(a.inner[:], address)
 	if err != nil {
 		return nil, fmt.Errorf("%w: base58 decoding error %w", ErrBadAddressSolana, err)
 	}
-	return NewSolanaAddress(b)
+	if n != SolanaAddressLength {
+		return nil, fmt.Errorf("%w: length error, given %d, expected %d", ErrBadAddressSolana, n, SolanaAddressLength)
+	}
+	return a, nil
 }
 
 // String returns the base58 encoding of the address as common in the Solana ecosystem
@@ -64,6 +69,16 @@ func (s *SolanaAddress) Bytes() []byte {
 	return buf
 }
 
+// Bytes32 returns the bytes of the address
+func (s *SolanaAddress) Bytes32() ([Bytes32Length]byte, error) {
+	return s.inner, nil
+}
+
+// Key returns the comparable representation of the address
+func (s *SolanaAddress) Key() AddressKey {
+	return newAddressKey(s.Ecosystem(), s.Bytes())
+}
+
 func (s *SolanaAddress) Length() int {
 	return SolanaAddressLength
 }
@@ -73,6 +88,9 @@ func (s *SolanaAddress) Ecosystem() chainid.Ecosystem {
 }
 
 func (a1 *SolanaAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
 	if a2.Ecosystem() != a1.Ecosystem() {
 		return false
 	}
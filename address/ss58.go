@@ -0,0 +1,224 @@
+package address
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/base58"
+	"github.com/lombard-finance/ledger-utils/common/blake2b"
+)
+
+// SS58AccountIdLength is the length of a Substrate account id
+const SS58AccountIdLength = 32
+
+// Well known SS58 network prefixes
+const (
+	SS58PolkadotPrefix uint16 = 0
+	SS58KusamaPrefix   uint16 = 2
+	SS58GenericPrefix  uint16 = 42
+)
+
+// SS58MaxPrefix is the largest network prefix representable in SS58
+const SS58MaxPrefix uint16 = 16383
+
+const (
+	// ss58MaxSimplePrefix is the largest prefix encoded in a single byte
+	ss58MaxSimplePrefix = 63
+	// ss58ChecksumLength is the length of the checksum of 32 bytes account ids
+	ss58ChecksumLength = 2
+	// ss58MaxDecodedLength is the length of an address with a two bytes prefix
+	ss58MaxDecodedLength = 2 + SS58AccountIdLength + ss58ChecksumLength
+)
+
+// ss58ChecksumPreimage is prepended to the address bytes when computing the checksum
+var ss58ChecksumPreimage = []byte("SS58PRE")
+
+// ErrBadAddressSubstrate is an ErrBadAddress specialized for Substrate
+var ErrBadAddressSubstrate = fmt.Errorf("substrate %w", ErrBadAddress)
+
+// SS58Address is the address type for Substrate chains, i.e. a 32 bytes account id displayed with the SS58
+// encoding. The network prefix only affects the SS58 representation and is not considered when comparing
+// addresses, since the same account id is valid on all the chains.
+type SS58Address struct {
+	prefix  uint16
+	account [SS58AccountIdLength]byte
+}
+
+// NewSS58Address creates a new SS58Address from the 32 bytes account id, using the generic Substrate prefix
+func NewSS58Address(b []byte) (*SS58Address, error) {
+	if len(b) != SS58AccountIdLength {
+		return nil, fmt.Errorf("%w: length error, given %d, expected %d", ErrBadAddressSubstrate, len(b), SS58AccountIdLength)
+	}
+	a := &SS58Address{prefix: SS58GenericPrefix}
+	copy(a.account[:], b)
+	return a, nil
+}
+
+// NewSS58AddressFromHex creates a new SS58Address from the hex encoding of the account id, using the generic
+// Substrate prefix. Both string with and without leading 0x are supported
+func NewSS58AddressFromHex(address string) (*SS58Address, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: hex decoding error %w", ErrBadAddressSubstrate, err)
+	}
+	return NewSS58Address(b)
+}
+
+// NewSS58AddressFromSS58 creates a new SS58Address from its SS58 representation with any network prefix,
+// verifying the checksum. The network prefix is preserved.
+func NewSS58AddressFromSS58(address string) (*SS58Address, error) {
+	var decoded [ss58MaxDecodedLength]byte
+	n, err := base58.DecodeInto(decoded[:], address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: base58 decoding error %w", ErrBadAddressSubstrate, err)
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrBadAddressSubstrate, ErrEmptyAddress)
+	}
+	data := decoded[:n]
+	prefix, prefixLength := uint16(data[0]), 1
+	switch {
+	case data[0] <= ss58MaxSimplePrefix:
+	case data[0] < 128 && n > 1:
+		lower := uint16(data[0]&0x3f)<<2 | uint16(data[1])>>6
+		upper := uint16(data[1] & 0x3f)
+		prefix, prefixLength = lower|upper<<8, 2
+		if prefix <= ss58MaxSimplePrefix {
+			return nil, fmt.Errorf("%w: non canonical prefix %d", ErrBadAddressSubstrate, prefix)
+		}
+	default:
+		return nil, fmt.Errorf("%w: invalid prefix byte %#x", ErrBadAddressSubstrate, data[0])
+	}
+	if n != prefixLength+SS58AccountIdLength+ss58ChecksumLength {
+		return nil, fmt.Errorf(
+			"%w: length error, given %d, expected %d",
+			ErrBadAddressSubstrate, n, prefixLength+SS58AccountIdLength+ss58ChecksumLength,
+		)
+	}
+	body, checksum := data[:n-ss58ChecksumLength], data[n-ss58ChecksumLength:]
+	if !bytes.Equal(ss58Checksum(body), checksum) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrBadAddressSubstrate)
+	}
+	a := &SS58Address{prefix: prefix}
+	copy(a.account[:], body[prefixLength:])
+	return a, nil
+}
+
+// NewSS58AddressFromString creates a new SS58Address either from the SS58 representation or from the hex
+// encoding of the account id, which must be led by 0x
+func NewSS58AddressFromString(address string) (*SS58Address, error) {
+	if strings.HasPrefix(address, "0x") {
+		return NewSS58AddressFromHex(address)
+	}
+	return NewSS58AddressFromSS58(address)
+}
+
+// ss58Checksum returns the SS58 checksum of the prefix and account id bytes
+func ss58Checksum(body []byte) []byte {
+	hash := blake2b.Sum512(append(bytes.Clone(ss58ChecksumPreimage), body...))
+	return hash[:ss58ChecksumLength]
+}
+
+// ss58PrefixBytes returns the one or two bytes encoding of the network prefix
+func ss58PrefixBytes(prefix uint16) []byte {
+	if prefix <= ss58MaxSimplePrefix {
+		return []byte{byte(prefix)}
+	}
+	return []byte{
+		byte((prefix&0xfc)>>2) | 0x40,
+		byte(prefix>>8) | byte(prefix&0x03)<<6,
+	}
+}
+
+func checkSS58Prefix(prefix uint16) error {
+	if prefix > SS58MaxPrefix {
+		return fmt.Errorf("%w: prefix %d exceeds %d", ErrBadAddressSubstrate, prefix, SS58MaxPrefix)
+	}
+	return nil
+}
+
+// Prefix returns the network prefix used by the SS58 representation of the address
+func (a *SS58Address) Prefix() uint16 {
+	return a.prefix
+}
+
+// AccountId returns the account id of the address
+func (a *SS58Address) AccountId() [SS58AccountIdLength]byte {
+	return a.account
+}
+
+// WithPrefix returns a copy of the address with the given network prefix
+func (a *SS58Address) WithPrefix(prefix uint16) (*SS58Address, error) {
+	if err := checkSS58Prefix(prefix); err != nil {
+		return nil, err
+	}
+	out := *a
+	out.prefix = prefix
+	return &out, nil
+}
+
+// Encode returns the SS58 representation of the account id for the given network prefix
+func (a *SS58Address) Encode(prefix uint16) (string, error) {
+	if err := checkSS58Prefix(prefix); err != nil {
+		return "", err
+	}
+	return a.encode(prefix), nil
+}
+
+func (a *SS58Address) encode(prefix uint16) string {
+	data := append(ss58PrefixBytes(prefix), a.account[:]...)
+	data = append(data, ss58Checksum(data)...)
+	return base58.Encode(data)
+}
+
+// String returns the SS58 representation of the address with its network prefix
+func (a *SS58Address) String() string {
+	return a.encode(a.prefix)
+}
+
+func (a *SS58Address) Hex() string {
+	return hex.EncodeToString(a.account[:])
+}
+
+// Bytes returns the account id
+func (a *SS58Address) Bytes() []byte {
+	buf := make([]byte, SS58AccountIdLength)
+	copy(buf, a.account[:])
+	return buf
+}
+
+// Bytes32 returns the account id, regardless of the network prefix
+func (a *SS58Address) Bytes32() ([Bytes32Length]byte, error) {
+	return a.account, nil
+}
+
+// Key returns the comparable representation of the address
+func (a *SS58Address) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *SS58Address) Length() int {
+	return SS58AccountIdLength
+}
+
+func (a *SS58Address) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemSubstrate
+}
+
+// Equal reports whether the two addresses have the same account id, regardless of the network prefix
+func (a1 *SS58Address) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsSS58, ok := a2.(*SS58Address)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(a1.account[:], a2AsSS58.account[:])
+}
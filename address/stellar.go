@@ -0,0 +1,227 @@
+package address
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/crc16"
+)
+
+// StellarKeyLength is the length of an account public key or of a contract id
+const StellarKeyLength = 32
+
+// StellarMuxedIdLength is the length of the id of a muxed account
+const StellarMuxedIdLength = 8
+
+// StellarAddressKind identifies the kind of a Stellar address according to its StrKey version byte
+type StellarAddressKind byte
+
+// StrKey version bytes, i.e. the base32 index of the leading char shifted by 3 bits
+const (
+	StellarAccount      StellarAddressKind = 6 << 3  // G...
+	StellarMuxedAccount StellarAddressKind = 12 << 3 // M...
+	StellarContract     StellarAddressKind = 2 << 3  // C...
+)
+
+func (k StellarAddressKind) String() string {
+	switch k {
+	case StellarAccount:
+		return "account"
+	case StellarMuxedAccount:
+		return "muxed account"
+	case StellarContract:
+		return "contract"
+	default:
+		return fmt.Sprintf("kind %d", k)
+	}
+}
+
+// payloadLength returns the length of the StrKey payload of the kind
+func (k StellarAddressKind) payloadLength() int {
+	if k == StellarMuxedAccount {
+		return StellarKeyLength + StellarMuxedIdLength
+	}
+	return StellarKeyLength
+}
+
+var stellarEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ErrBadAddressStellar is an ErrBadAddress specialized for Stellar
+var ErrBadAddressStellar = fmt.Errorf("stellar %w", ErrBadAddress)
+
+// StellarAddress is the address type for the Stellar blockchain. It covers accounts (G...), muxed accounts (M...)
+// and Soroban contracts (C...), all represented as StrKey. Bytes are the version byte followed by the StrKey
+// payload, so that the kind of the address is preserved.
+type StellarAddress struct {
+	kind    StellarAddressKind
+	key     [StellarKeyLength]byte
+	muxedId uint64
+}
+
+// NewStellarAddress creates a new StellarAddress from a slice of bytes made of the StrKey version byte followed
+// by the payload. A 32 bytes slice is interpreted as the public key of an account.
+func NewStellarAddress(b []byte) (*StellarAddress, error) {
+	if len(b) == StellarKeyLength {
+		a := &StellarAddress{kind: StellarAccount}
+		copy(a.key[:], b)
+		return a, nil
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrBadAddressStellar, ErrEmptyAddress)
+	}
+	return newStellarAddress(StellarAddressKind(b[0]), b[1:])
+}
+
+// NewStellarAccountAddress creates the StellarAddress of the account with the given ed25519 public key
+func NewStellarAccountAddress(publicKey []byte) (*StellarAddress, error) {
+	return newStellarAddress(StellarAccount, publicKey)
+}
+
+// NewStellarContractAddress creates the StellarAddress of the Soroban contract with the given id
+func NewStellarContractAddress(contractId []byte) (*StellarAddress, error) {
+	return newStellarAddress(StellarContract, contractId)
+}
+
+// NewStellarMuxedAddress creates the StellarAddress of the muxed account with the given public key and id
+func NewStellarMuxedAddress(publicKey []byte, id uint64) (*StellarAddress, error) {
+	payload := make([]byte, 0, StellarKeyLength+StellarMuxedIdLength)
+	payload = append(payload, publicKey...)
+	payload = binary.BigEndian.AppendUint64(payload, id)
+	return newStellarAddress(StellarMuxedAccount, payload)
+}
+
+// NewStellarAddressFromStrKey creates a new StellarAddress from its StrKey representation, verifying the
+// CRC16 checksum
+func NewStellarAddressFromStrKey(address string) (*StellarAddress, error) {
+	decoded, err := stellarEncoding.DecodeString(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: base32 decoding error %w", ErrBadAddressStellar, err)
+	}
+	if len(decoded) < 3 {
+		return nil, fmt.Errorf("%w: length error, given %d bytes", ErrBadAddressStellar, len(decoded))
+	}
+	data, checksum := decoded[:len(decoded)-2], decoded[len(decoded)-2:]
+	if crc16.XModem(data) != binary.LittleEndian.Uint16(checksum) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrBadAddressStellar)
+	}
+	a, err := newStellarAddress(StellarAddressKind(data[0]), data[1:])
+	if err != nil {
+		return nil, err
+	}
+	// reject non canonical encodings, whose trailing bits are not zeroes
+	if a.String() != address {
+		return nil, fmt.Errorf("%w: non canonical encoding", ErrBadAddressStellar)
+	}
+	return a, nil
+}
+
+func newStellarAddress(kind StellarAddressKind, payload []byte) (*StellarAddress, error) {
+	switch kind {
+	case StellarAccount, StellarMuxedAccount, StellarContract:
+	default:
+		return nil, fmt.Errorf("%w: unsupported version byte %#x", ErrBadAddressStellar, byte(kind))
+	}
+	if len(payload) != kind.payloadLength() {
+		return nil, fmt.Errorf(
+			"%w: %s length error, given %d, expected %d",
+			ErrBadAddressStellar, kind, len(payload), kind.payloadLength(),
+		)
+	}
+	a := &StellarAddress{kind: kind}
+	copy(a.key[:], payload)
+	if kind == StellarMuxedAccount {
+		a.muxedId = binary.BigEndian.Uint64(payload[StellarKeyLength:])
+	}
+	return a, nil
+}
+
+// Kind returns the kind of the address
+func (a *StellarAddress) Kind() StellarAddressKind {
+	return a.kind
+}
+
+// RawKey returns the ed25519 public key of accounts and muxed accounts, or the id of contracts
+func (a *StellarAddress) RawKey() [StellarKeyLength]byte {
+	return a.key
+}
+
+// MuxedId returns the id of a muxed account and whether the address is a muxed account
+func (a *StellarAddress) MuxedId() (uint64, bool) {
+	return a.muxedId, a.kind == StellarMuxedAccount
+}
+
+// BaseAccount returns the account underlying a muxed account. Other kinds of address are returned as-is.
+func (a *StellarAddress) BaseAccount() *StellarAddress {
+	if a.kind != StellarMuxedAccount {
+		return a
+	}
+	return &StellarAddress{kind: StellarAccount, key: a.key}
+}
+
+// payload returns the StrKey payload of the address
+func (a *StellarAddress) payload() []byte {
+	buf := make([]byte, 0, a.kind.payloadLength())
+	buf = append(buf, a.key[:]...)
+	if a.kind == StellarMuxedAccount {
+		buf = binary.BigEndian.AppendUint64(buf, a.muxedId)
+	}
+	return buf
+}
+
+// String returns the StrKey representation of the address
+func (a *StellarAddress) String() string {
+	data := a.Bytes()
+	data = binary.LittleEndian.AppendUint16(data, crc16.XModem(data))
+	return stellarEncoding.EncodeToString(data)
+}
+
+func (a *StellarAddress) Hex() string {
+	return hex.EncodeToString(a.Bytes())
+}
+
+// Bytes returns the StrKey version byte followed by the payload
+func (a *StellarAddress) Bytes() []byte {
+	return append([]byte{byte(a.kind)}, a.payload()...)
+}
+
+// Bytes32 returns the public key of accounts and the id of contracts, which cannot be told apart once decoded.
+// Muxed accounts are not representable, since they carry the id besides the public key.
+func (a *StellarAddress) Bytes32() ([Bytes32Length]byte, error) {
+	if a.kind == StellarMuxedAccount {
+		return [Bytes32Length]byte{}, fmt.Errorf("%w: stellar muxed account", ErrNotRepresentableBytes32)
+	}
+	return a.key, nil
+}
+
+// Key returns the comparable representation of the address
+func (a *StellarAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *StellarAddress) Length() int {
+	return 1 + a.kind.payloadLength()
+}
+
+func (a *StellarAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemStellar
+}
+
+func (a1 *StellarAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsStellar, ok := a2.(*StellarAddress)
+	if !ok {
+		return false
+	}
+	return a1.kind == a2AsStellar.kind &&
+		a1.muxedId == a2AsStellar.muxedId &&
+		bytes.Equal(a1.key[:], a2AsStellar.key[:])
+}
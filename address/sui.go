@@ -53,6 +53,16 @@ func (s *SuiAddress) Bytes() []byte {
 	return buf
 }
 
+// Bytes32 returns the bytes of the address
+func (s *SuiAddress) Bytes32() ([Bytes32Length]byte, error) {
+	return s.inner, nil
+}
+
+// Key returns the comparable representation of the address
+func (s *SuiAddress) Key() AddressKey {
+	return newAddressKey(s.Ecosystem(), s.Bytes())
+}
+
 func (s *SuiAddress) Length() int {
 	return SuiAddressLength
 }
@@ -62,6 +72,9 @@ func (s *SuiAddress) Ecosystem() chainid.Ecosystem {
 }
 
 func (a1 *SuiAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
 	if a2.Ecosystem() != a1.Ecosystem() {
 		return false
 	}
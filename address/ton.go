@@ -0,0 +1,232 @@
+package address
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/crc16"
+)
+
+// TonHashLength is the length of the account id, i.e. the hash of the initial state of the account
+const TonHashLength = 32
+
+// TonAddressLength is the length of the address bytes: workchain (1 byte) followed by the account hash
+const TonAddressLength = 1 + TonHashLength
+
+// TonUserFriendlyLength is the length of the base64 user-friendly representation of an address
+const TonUserFriendlyLength = 48
+
+const (
+	tonBounceableTag    byte = 0x11
+	tonNonBounceableTag byte = 0x51
+	tonTestnetFlag      byte = 0x80
+)
+
+// ErrBadAddressTon is an ErrBadAddress specialized for TON
+var ErrBadAddressTon = fmt.Errorf("ton %w", ErrBadAddress)
+
+// TonAddress is the address type for the TON blockchain. It is made of the workchain and the account hash,
+// while the bounceable and testnet-only flags only affect the user-friendly representation and are not
+// considered when comparing addresses.
+type TonAddress struct {
+	workchain   int8
+	hash        [TonHashLength]byte
+	bounceable  bool
+	testnetOnly bool
+}
+
+// NewTonAddress creates a new TonAddress from a slice of bytes made of the workchain byte followed by the
+// 32 bytes account hash. A 32 bytes slice is interpreted as an account hash on the basechain (workchain 0).
+// The address is bounceable and not testnet-only.
+func NewTonAddress(b []byte) (*TonAddress, error) {
+	a := &TonAddress{bounceable: true}
+	switch len(b) {
+	case TonHashLength:
+		copy(a.hash[:], b)
+	case TonAddressLength:
+		a.workchain = int8(b[0])
+		copy(a.hash[:], b[1:])
+	default:
+		return nil, fmt.Errorf(
+			"%w: length error, given %d, expected %d or %d",
+			ErrBadAddressTon, len(b), TonAddressLength, TonHashLength,
+		)
+	}
+	return a, nil
+}
+
+// NewTonAddressFromRaw creates a new TonAddress from the raw form `workchain:hex`, e.g. `0:ed16...`.
+// The address is bounceable and not testnet-only.
+func NewTonAddressFromRaw(address string) (*TonAddress, error) {
+	workchainString, hashString, found := strings.Cut(address, ":")
+	if !found {
+		return nil, fmt.Errorf("%w: raw form must be workchain:hash", ErrBadAddressTon)
+	}
+	workchain, err := strconv.ParseInt(workchainString, 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("%w: workchain error %w", ErrBadAddressTon, err)
+	}
+	if len(hashString) != TonHashLength*2 {
+		return nil, fmt.Errorf(
+			"%w: length error, given %d hex chars, expected %d",
+			ErrBadAddressTon, len(hashString), TonHashLength*2,
+		)
+	}
+	a := &TonAddress{workchain: int8(workchain), bounceable: true}
+	if _, err := hex.Decode(a.hash[:], []byte(hashString)); err != nil {
+		return nil, fmt.Errorf("%w: hex decoding error %w", ErrBadAddressTon, err)
+	}
+	return a, nil
+}
+
+// NewTonAddressFromUserFriendly creates a new TonAddress from the 48 chars user-friendly form, encoded either in
+// standard or url-safe base64. The CRC16 checksum is verified and the flags are preserved.
+func NewTonAddressFromUserFriendly(address string) (*TonAddress, error) {
+	if len(address) != TonUserFriendlyLength {
+		return nil, fmt.Errorf(
+			"%w: length error, given %d chars, expected %d",
+			ErrBadAddressTon, len(address), TonUserFriendlyLength,
+		)
+	}
+	encoding := base64.URLEncoding
+	if strings.ContainsAny(address, "+/") {
+		encoding = base64.StdEncoding
+	}
+	var b [36]byte
+	if _, err := encoding.Decode(b[:], []byte(address)); err != nil {
+		return nil, fmt.Errorf("%w: base64 decoding error %w", ErrBadAddressTon, err)
+	}
+	if crc16.XModem(b[:34]) != binary.BigEndian.Uint16(b[34:]) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrBadAddressTon)
+	}
+	tag := b[0]
+	a := &TonAddress{workchain: int8(b[1]), testnetOnly: tag&tonTestnetFlag != 0}
+	switch tag &^ tonTestnetFlag {
+	case tonBounceableTag:
+		a.bounceable = true
+	case tonNonBounceableTag:
+	default:
+		return nil, fmt.Errorf("%w: unknown tag %#x", ErrBadAddressTon, tag)
+	}
+	copy(a.hash[:], b[2:34])
+	return a, nil
+}
+
+// NewTonAddressFromString creates a new TonAddress from either the raw or the user-friendly form
+func NewTonAddressFromString(address string) (*TonAddress, error) {
+	if strings.Contains(address, ":") {
+		return NewTonAddressFromRaw(address)
+	}
+	return NewTonAddressFromUserFriendly(address)
+}
+
+// Workchain returns the workchain of the address, e.g. 0 for the basechain and -1 for the masterchain
+func (a *TonAddress) Workchain() int8 {
+	return a.workchain
+}
+
+// Hash returns the account hash of the address
+func (a *TonAddress) Hash() [TonHashLength]byte {
+	return a.hash
+}
+
+// IsBounceable reports whether the address is flagged as bounceable in its user-friendly form
+func (a *TonAddress) IsBounceable() bool {
+	return a.bounceable
+}
+
+// IsTestnetOnly reports whether the address is flagged as testnet-only in its user-friendly form
+func (a *TonAddress) IsTestnetOnly() bool {
+	return a.testnetOnly
+}
+
+// WithFlags returns a copy of the address with the given user-friendly flags
+func (a *TonAddress) WithFlags(bounceable bool, testnetOnly bool) *TonAddress {
+	out := *a
+	out.bounceable = bounceable
+	out.testnetOnly = testnetOnly
+	return &out
+}
+
+// Raw returns the raw form of the address `workchain:hex`
+func (a *TonAddress) Raw() string {
+	return strconv.Itoa(int(a.workchain)) + ":" + hex.EncodeToString(a.hash[:])
+}
+
+// UserFriendly returns the url-safe base64 user-friendly form of the address, carrying its flags
+func (a *TonAddress) UserFriendly() string {
+	var b [36]byte
+	b[0] = tonNonBounceableTag
+	if a.bounceable {
+		b[0] = tonBounceableTag
+	}
+	if a.testnetOnly {
+		b[0] |= tonTestnetFlag
+	}
+	b[1] = byte(a.workchain)
+	copy(b[2:34], a.hash[:])
+	binary.BigEndian.PutUint16(b[34:], crc16.XModem(b[:34]))
+	return base64.URLEncoding.EncodeToString(b[:])
+}
+
+// String returns the user-friendly form of the address as common in the TON ecosystem
+func (a *TonAddress) String() string {
+	return a.UserFriendly()
+}
+
+func (a *TonAddress) Hex() string {
+	return hex.EncodeToString(a.Bytes())
+}
+
+// Bytes returns the workchain byte followed by the account hash
+func (a *TonAddress) Bytes() []byte {
+	buf := make([]byte, TonAddressLength)
+	buf[0] = byte(a.workchain)
+	copy(buf[1:], a.hash[:])
+	return buf
+}
+
+// Bytes32 returns the account hash of basechain addresses. Addresses of other workchains are not representable,
+// since the account hash takes all the 32 bytes.
+func (a *TonAddress) Bytes32() ([Bytes32Length]byte, error) {
+	if a.workchain != 0 {
+		return [Bytes32Length]byte{}, fmt.Errorf(
+			"%w: ton address on workchain %d", ErrNotRepresentableBytes32, a.workchain,
+		)
+	}
+	return a.hash, nil
+}
+
+// Key returns the comparable representation of the address
+func (a *TonAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *TonAddress) Length() int {
+	return TonAddressLength
+}
+
+func (a *TonAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemTon
+}
+
+// Equal reports whether the two addresses have the same workchain and hash, regardless of the flags
+func (a1 *TonAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsTon, ok := a2.(*TonAddress)
+	if !ok {
+		return false
+	}
+	return a1.workchain == a2AsTon.workchain && bytes.Equal(a1.hash[:], a2AsTon.hash[:])
+}
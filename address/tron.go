@@ -0,0 +1,136 @@
+package address
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/base58"
+)
+
+// TronAddressLength is the length of a Tron account, which shares the layout of EVM addresses
+const TronAddressLength = EvmAddressLength
+
+// TronAddressPrefix is the byte prepended to the account in the hex and base58check forms
+const TronAddressPrefix byte = 0x41
+
+// ErrBadAddressTron is an ErrBadAddress specialized for Tron
+var ErrBadAddressTron = fmt.Errorf("tron %w", ErrBadAddress)
+
+// TronAddress is the address type for the Tron blockchain. Bytes are the 20 bytes of the account, as in the
+// EVM, while the string form is the base58check encoding of the account prefixed by 0x41 (`T...`).
+type TronAddress struct {
+	inner [TronAddressLength]byte
+}
+
+// NewTronAddress creates a new TronAddress from a byte slice. A 21 bytes slice starting with 0x41 is accepted,
+// otherwise the same rules of NewEvmAddress apply.
+func NewTronAddress(b []byte) (*TronAddress, error) {
+	if len(b) == TronAddressLength+1 && b[0] == TronAddressPrefix {
+		b = b[1:]
+	}
+	evm, err := NewEvmAddress(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadAddressTron, err)
+	}
+	return EvmToTronAddress(evm), nil
+}
+
+// NewTronAddressFromHex creates a new TronAddress from an hex string, either in the Tron form with the leading
+// 41 or in the EVM form. Both string with and without leading 0x are supported
+func NewTronAddressFromHex(address string) (*TronAddress, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: hex decoding error %w", ErrBadAddressTron, err)
+	}
+	return NewTronAddress(decoded)
+}
+
+// NewTronAddressFromBase58 creates a new TronAddress from the base58check form (`T...`)
+func NewTronAddressFromBase58(address string) (*TronAddress, error) {
+	version, payload, err := base58.CheckDecode(address, 1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: base58check decoding error %w", ErrBadAddressTron, err)
+	}
+	if version[0] != TronAddressPrefix {
+		return nil, fmt.Errorf("%w: prefix error, given %#x, expected %#x", ErrBadAddressTron, version[0], TronAddressPrefix)
+	}
+	if len(payload) != TronAddressLength {
+		return nil, fmt.Errorf("%w: length error, given %d, expected %d", ErrBadAddressTron, len(payload), TronAddressLength)
+	}
+	a := &TronAddress{}
+	copy(a.inner[:], payload)
+	return a, nil
+}
+
+// NewTronAddressFromString creates a new TronAddress either from the base58check or from the hex form
+func NewTronAddressFromString(address string) (*TronAddress, error) {
+	if strings.HasPrefix(address, "T") {
+		return NewTronAddressFromBase58(address)
+	}
+	return NewTronAddressFromHex(address)
+}
+
+// EvmToTronAddress returns the TronAddress carrying the same 20 bytes of the EvmAddress
+func EvmToTronAddress(a *EvmAddress) *TronAddress {
+	return &TronAddress{inner: a.inner}
+}
+
+// TronToEvmAddress returns the EvmAddress carrying the same 20 bytes of the TronAddress, as used at ABI level
+func TronToEvmAddress(a *TronAddress) *EvmAddress {
+	return &EvmAddress{inner: a.inner}
+}
+
+// String returns the base58check encoding of the address as common in the Tron ecosystem
+func (a *TronAddress) String() string {
+	return base58.CheckEncode([]byte{TronAddressPrefix}, a.inner[:])
+}
+
+// TronHex returns the hex encoding of the address prefixed by 41, as used by Tron nodes
+func (a *TronAddress) TronHex() string {
+	return hex.EncodeToString([]byte{TronAddressPrefix}) + a.Hex()
+}
+
+func (a *TronAddress) Hex() string {
+	return hex.EncodeToString(a.inner[:])
+}
+
+func (a *TronAddress) Bytes() []byte {
+	buf := make([]byte, TronAddressLength)
+	copy(buf, a.inner[:])
+	return buf
+}
+
+// Bytes32 returns the 20 bytes address left padded with zeroes, without the leading 41
+func (a *TronAddress) Bytes32() ([Bytes32Length]byte, error) {
+	return pad32(a.inner[:]), nil
+}
+
+// Key returns the comparable representation of the address
+func (a *TronAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *TronAddress) Length() int {
+	return TronAddressLength
+}
+
+func (a *TronAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemTron
+}
+
+func (a1 *TronAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsTron, ok := a2.(*TronAddress)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(a1.inner[:], a2AsTron.inner[:])
+}
@@ -0,0 +1,299 @@
+package address
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/base58"
+	"github.com/lombard-finance/ledger-utils/common/bech32"
+)
+
+// UtxoHashLength is the length of the hash160 of P2PKH, P2SH and P2WPKH addresses
+const UtxoHashLength = 20
+
+// UtxoWitnessProgramLength is the length of the witness program of P2WSH and P2TR addresses
+const UtxoWitnessProgramLength = 32
+
+// Script opcodes used by standard output scripts
+const (
+	opDup         byte = 0x76
+	opHash160     byte = 0xa9
+	opEqual       byte = 0x87
+	opEqualVerify byte = 0x88
+	opCheckSig    byte = 0xac
+	op0           byte = 0x00
+	op1           byte = 0x51
+)
+
+// UtxoAddressType is the type of output a UTXO address pays to
+type UtxoAddressType byte
+
+const (
+	// UtxoP2PKH is a pay-to-pubkey-hash address, encoded in base58check
+	UtxoP2PKH UtxoAddressType = iota
+	// UtxoP2SH is a pay-to-script-hash address, encoded in base58check
+	UtxoP2SH
+	// UtxoP2WPKH is a segwit v0 pay-to-witness-pubkey-hash address, encoded in bech32
+	UtxoP2WPKH
+	// UtxoP2WSH is a segwit v0 pay-to-witness-script-hash address, encoded in bech32
+	UtxoP2WSH
+	// UtxoP2TR is a segwit v1 pay-to-taproot address, encoded in bech32m
+	UtxoP2TR
+)
+
+func (t UtxoAddressType) String() string {
+	switch t {
+	case UtxoP2PKH:
+		return "p2pkh"
+	case UtxoP2SH:
+		return "p2sh"
+	case UtxoP2WPKH:
+		return "p2wpkh"
+	case UtxoP2WSH:
+		return "p2wsh"
+	case UtxoP2TR:
+		return "p2tr"
+	default:
+		return fmt.Sprintf("type %d", t)
+	}
+}
+
+// ErrBadAddressUtxo is an ErrBadAddress specialized for Bitcoin-like UTXO networks
+var ErrBadAddressUtxo = fmt.Errorf("utxo %w", ErrBadAddress)
+
+// ErrUtxoUnsupportedByNetwork is returned when an address type is not supported by the network
+var ErrUtxoUnsupportedByNetwork = fmt.Errorf("%w: address type not supported by the network", ErrBadAddressUtxo)
+
+// UtxoAddress is the address type for Bitcoin-like UTXO networks, whose string form depends on the
+// chainid.UtxoNetworkParams of the network. Bytes are the output script the address pays to, so that the same
+// address has the same bytes on any network. The network parameters only affect the string form and are not
+// considered when comparing addresses.
+type UtxoAddress struct {
+	typ     UtxoAddressType
+	program []byte
+	params  chainid.UtxoNetworkParams
+}
+
+// NewUtxoAddressFromString creates a new UtxoAddress from its base58check or segwit representation on the
+// network described by params. Segwit and taproot addresses are accepted only if supported by the network.
+func NewUtxoAddressFromString(address string, params chainid.UtxoNetworkParams) (*UtxoAddress, error) {
+	if params.Bech32Prefix != "" && strings.HasPrefix(strings.ToLower(address), params.Bech32Prefix+"1") {
+		return newUtxoSegwitAddress(address, params)
+	}
+	version, payload, err := base58.CheckDecode(address, 1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: base58check decoding error %w", ErrBadAddressUtxo, err)
+	}
+	if len(payload) != UtxoHashLength {
+		return nil, fmt.Errorf("%w: length error, given %d, expected %d", ErrBadAddressUtxo, len(payload), UtxoHashLength)
+	}
+	a := &UtxoAddress{program: payload, params: params}
+	switch version[0] {
+	case params.P2PKHVersion:
+		a.typ = UtxoP2PKH
+	case params.P2SHVersion:
+		a.typ = UtxoP2SH
+	default:
+		return nil, fmt.Errorf("%w: unknown version %#x for %s", ErrBadAddressUtxo, version[0], params.Name)
+	}
+	return a, nil
+}
+
+func newUtxoSegwitAddress(address string, params chainid.UtxoNetworkParams) (*UtxoAddress, error) {
+	hrp, data, variant, err := bech32.DecodeToBase32(address, bech32.MaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bech32 decoding error %w", ErrBadAddressUtxo, err)
+	}
+	if hrp != params.Bech32Prefix {
+		return nil, fmt.Errorf("%w: unexpected hrp %s for %s", ErrBadAddressUtxo, hrp, params.Name)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: missing witness version", ErrBadAddressUtxo)
+	}
+	program, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bech32 decoding error %w", ErrBadAddressUtxo, err)
+	}
+	a, err := newUtxoWitnessAddress(data[0], program, params)
+	if err != nil {
+		return nil, err
+	}
+	// BIP-350: version 0 uses bech32 while later versions use bech32m
+	if expected := a.variant(); variant != expected {
+		return nil, fmt.Errorf("%w: expected %s, given %s", ErrBadAddressUtxo, expected, variant)
+	}
+	return a, nil
+}
+
+func newUtxoWitnessAddress(version byte, program []byte, params chainid.UtxoNetworkParams) (*UtxoAddress, error) {
+	a := &UtxoAddress{program: program, params: params}
+	switch {
+	case version == 0 && len(program) == UtxoHashLength:
+		a.typ = UtxoP2WPKH
+	case version == 0 && len(program) == UtxoWitnessProgramLength:
+		a.typ = UtxoP2WSH
+	case version == 0:
+		return nil, fmt.Errorf("%w: witness v0 program length error, given %d", ErrBadAddressUtxo, len(program))
+	case version == 1 && len(program) == UtxoWitnessProgramLength:
+		a.typ = UtxoP2TR
+	default:
+		return nil, fmt.Errorf(
+			"%w: unsupported witness v%d program of %d bytes",
+			ErrBadAddressUtxo, version, len(program),
+		)
+	}
+	if err := a.checkNetwork(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// NewUtxoAddressFromScript creates a new UtxoAddress from a standard output script, i.e. P2PKH, P2SH, P2WPKH,
+// P2WSH or P2TR, to be represented on the network described by params.
+func NewUtxoAddressFromScript(script []byte, params chainid.UtxoNetworkParams) (*UtxoAddress, error) {
+	n := len(script)
+	switch {
+	case n == 25 && script[0] == opDup && script[1] == opHash160 && script[2] == UtxoHashLength &&
+		script[23] == opEqualVerify && script[24] == opCheckSig:
+		return &UtxoAddress{typ: UtxoP2PKH, program: bytes.Clone(script[3:23]), params: params}, nil
+	case n == 23 && script[0] == opHash160 && script[1] == UtxoHashLength && script[22] == opEqual:
+		return &UtxoAddress{typ: UtxoP2SH, program: bytes.Clone(script[2:22]), params: params}, nil
+	case n >= 4 && (script[0] == op0 || script[0] == op1) && int(script[1]) == n-2:
+		version := script[0]
+		if version != op0 {
+			version = version - op1 + 1
+		}
+		return newUtxoWitnessAddress(version, bytes.Clone(script[2:]), params)
+	default:
+		return nil, fmt.Errorf("%w: non standard output script", ErrBadAddressUtxo)
+	}
+}
+
+// checkNetwork returns ErrUtxoUnsupportedByNetwork if the address type is not supported by the network
+func (a *UtxoAddress) checkNetwork() error {
+	switch a.typ {
+	case UtxoP2WPKH, UtxoP2WSH:
+		if !a.params.SupportsSegwit {
+			return fmt.Errorf("%w: %s on %s", ErrUtxoUnsupportedByNetwork, a.typ, a.params.Name)
+		}
+	case UtxoP2TR:
+		if !a.params.SupportsTaproot {
+			return fmt.Errorf("%w: %s on %s", ErrUtxoUnsupportedByNetwork, a.typ, a.params.Name)
+		}
+	}
+	return nil
+}
+
+// witnessVersion returns the witness version of segwit addresses
+func (a *UtxoAddress) witnessVersion() byte {
+	if a.typ == UtxoP2TR {
+		return 1
+	}
+	return 0
+}
+
+// variant returns the bech32 variant encoding segwit addresses
+func (a *UtxoAddress) variant() bech32.Variant {
+	if a.witnessVersion() == 0 {
+		return bech32.Bech32
+	}
+	return bech32.Bech32m
+}
+
+// Type returns the type of the address
+func (a *UtxoAddress) Type() UtxoAddressType {
+	return a.typ
+}
+
+// Program returns the hash160 of P2PKH and P2SH addresses or the witness program of segwit addresses
+func (a *UtxoAddress) Program() []byte {
+	return bytes.Clone(a.program)
+}
+
+// Params returns the parameters of the network the address is represented for
+func (a *UtxoAddress) Params() chainid.UtxoNetworkParams {
+	return a.params
+}
+
+// WithParams returns a copy of the address represented for the network described by params, failing if the
+// network does not support the address type
+func (a *UtxoAddress) WithParams(params chainid.UtxoNetworkParams) (*UtxoAddress, error) {
+	out := &UtxoAddress{typ: a.typ, program: bytes.Clone(a.program), params: params}
+	if err := out.checkNetwork(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// String returns the base58check or segwit representation of the address according to the network parameters
+func (a *UtxoAddress) String() string {
+	switch a.typ {
+	case UtxoP2PKH:
+		return base58.CheckEncode([]byte{a.params.P2PKHVersion}, a.program)
+	case UtxoP2SH:
+		return base58.CheckEncode([]byte{a.params.P2SHVersion}, a.program)
+	default:
+		// program is already validated, so that conversion cannot fail
+		data, _ := bech32.ConvertBits(a.program, 8, 5, true)
+		s, _ := bech32.EncodeFromBase32(a.params.Bech32Prefix, append([]byte{a.witnessVersion()}, data...), a.variant())
+		return s
+	}
+}
+
+func (a *UtxoAddress) Hex() string {
+	return hex.EncodeToString(a.Bytes())
+}
+
+// Bytes returns the output script the address pays to
+func (a *UtxoAddress) Bytes() []byte {
+	switch a.typ {
+	case UtxoP2PKH:
+		script := []byte{opDup, opHash160, UtxoHashLength}
+		script = append(script, a.program...)
+		return append(script, opEqualVerify, opCheckSig)
+	case UtxoP2SH:
+		script := []byte{opHash160, UtxoHashLength}
+		script = append(script, a.program...)
+		return append(script, opEqual)
+	default:
+		version := op0
+		if a.witnessVersion() != 0 {
+			version = op1 + a.witnessVersion() - 1
+		}
+		return append([]byte{version, byte(len(a.program))}, a.program...)
+	}
+}
+
+// Bytes32 returns the output script left padded with zeroes for P2PKH, P2SH and P2WPKH. P2WSH and P2TR are not
+// representable, since their 32 bytes witness program leaves no room for the witness version.
+func (a *UtxoAddress) Bytes32() ([Bytes32Length]byte, error) {
+	return bitcoinBytes32(a.Bytes())
+}
+
+// Key returns the comparable representation of the address
+func (a *UtxoAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *UtxoAddress) Length() int {
+	return len(a.Bytes())
+}
+
+func (a *UtxoAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemBitcoin
+}
+
+// Equal reports whether the two addresses pay to the same output script, regardless of the network
+// parameters. Since Bitcoin addresses are generic by default, a GenericAddress with the same script is equal too.
+func (a1 *UtxoAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	return bytes.Equal(a1.Bytes(), a2.Bytes())
+}
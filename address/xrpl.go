@@ -0,0 +1,240 @@
+package address
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common/base58"
+)
+
+// XrplAccountIdLength is the length of an XRP Ledger account id
+const XrplAccountIdLength = 20
+
+// XrplTagLength is the length of a destination tag when included in the address bytes
+const XrplTagLength = 4
+
+// xrplAccountVersion is the Base58Check version byte of classic addresses (r...)
+const xrplAccountVersion byte = 0x00
+
+// X-address network prefixes, making encoded addresses start with X on mainnet and T on test networks
+var (
+	xrplXAddressMainnetPrefix = []byte{0x05, 0x44}
+	xrplXAddressTestnetPrefix = []byte{0x04, 0x93}
+)
+
+// xrplXAddressPayloadLength is the length of the X-address payload after the network prefix: account id,
+// flags byte and the 64 bits little endian tag
+const xrplXAddressPayloadLength = XrplAccountIdLength + 1 + 8
+
+// ErrBadAddressXrpl is an ErrBadAddress specialized for XRP Ledger
+var ErrBadAddressXrpl = fmt.Errorf("xrpl %w", ErrBadAddress)
+
+// XrplAddress is the address type for the XRP Ledger. It is made of the account id and an optional destination
+// tag, so that it covers both classic addresses (r...) and X-addresses (X... and T...). The network flag of
+// X-addresses only affects their representation and is not considered when comparing addresses.
+type XrplAddress struct {
+	account [XrplAccountIdLength]byte
+	tag     uint32
+	hasTag  bool
+	testnet bool
+}
+
+// NewXrplAddress creates a new XrplAddress from a slice of bytes made of the 20 bytes account id, optionally
+// followed by the destination tag as big endian u32.
+func NewXrplAddress(b []byte) (*XrplAddress, error) {
+	a := &XrplAddress{}
+	switch len(b) {
+	case XrplAccountIdLength:
+	case XrplAccountIdLength + XrplTagLength:
+		a.hasTag = true
+		a.tag = binary.BigEndian.Uint32(b[XrplAccountIdLength:])
+	default:
+		return nil, fmt.Errorf(
+			"%w: length error, given %d, expected %d or %d",
+			ErrBadAddressXrpl, len(b), XrplAccountIdLength, XrplAccountIdLength+XrplTagLength,
+		)
+	}
+	copy(a.account[:], b)
+	return a, nil
+}
+
+// NewXrplAddressFromClassic creates a new XrplAddress from a classic address (r...)
+func NewXrplAddressFromClassic(address string) (*XrplAddress, error) {
+	version, payload, err := base58.CheckDecodeAlphabet(address, 1, base58.RippleAlphabet)
+	if err != nil {
+		return nil, fmt.Errorf("%w: base58check decoding error %w", ErrBadAddressXrpl, err)
+	}
+	if version[0] != xrplAccountVersion {
+		return nil, fmt.Errorf("%w: version error, given %#x, expected %#x", ErrBadAddressXrpl, version[0], xrplAccountVersion)
+	}
+	if len(payload) != XrplAccountIdLength {
+		return nil, fmt.Errorf("%w: length error, given %d, expected %d", ErrBadAddressXrpl, len(payload), XrplAccountIdLength)
+	}
+	a := &XrplAddress{}
+	copy(a.account[:], payload)
+	return a, nil
+}
+
+// NewXrplAddressFromXAddress creates a new XrplAddress from an X-address, preserving destination tag and
+// network flag.
+func NewXrplAddressFromXAddress(address string) (*XrplAddress, error) {
+	prefix, payload, err := base58.CheckDecodeAlphabet(address, len(xrplXAddressMainnetPrefix), base58.RippleAlphabet)
+	if err != nil {
+		return nil, fmt.Errorf("%w: base58check decoding error %w", ErrBadAddressXrpl, err)
+	}
+	a := &XrplAddress{}
+	switch {
+	case bytes.Equal(prefix, xrplXAddressMainnetPrefix):
+	case bytes.Equal(prefix, xrplXAddressTestnetPrefix):
+		a.testnet = true
+	default:
+		return nil, fmt.Errorf("%w: unknown x-address prefix %x", ErrBadAddressXrpl, prefix)
+	}
+	if len(payload) != xrplXAddressPayloadLength {
+		return nil, fmt.Errorf("%w: length error, given %d, expected %d", ErrBadAddressXrpl, len(payload), xrplXAddressPayloadLength)
+	}
+	copy(a.account[:], payload)
+	flags := payload[XrplAccountIdLength]
+	tag := binary.LittleEndian.Uint64(payload[XrplAccountIdLength+1:])
+	switch flags {
+	case 0:
+		if tag != 0 {
+			return nil, fmt.Errorf("%w: tag is set but flagged as missing", ErrBadAddressXrpl)
+		}
+	case 1:
+		if tag > 0xffffffff {
+			return nil, fmt.Errorf("%w: tag exceeds 32 bits", ErrBadAddressXrpl)
+		}
+		a.hasTag = true
+		a.tag = uint32(tag)
+	default:
+		return nil, fmt.Errorf("%w: unsupported flags %#x", ErrBadAddressXrpl, flags)
+	}
+	return a, nil
+}
+
+// NewXrplAddressFromString creates a new XrplAddress either from a classic address or from an X-address
+func NewXrplAddressFromString(address string) (*XrplAddress, error) {
+	if strings.HasPrefix(address, "r") {
+		return NewXrplAddressFromClassic(address)
+	}
+	return NewXrplAddressFromXAddress(address)
+}
+
+// WithTag returns a copy of the address with the given destination tag
+func (a *XrplAddress) WithTag(tag uint32) *XrplAddress {
+	out := *a
+	out.tag = tag
+	out.hasTag = true
+	return &out
+}
+
+// Tag returns the destination tag and whether the address carries one
+func (a *XrplAddress) Tag() (uint32, bool) {
+	return a.tag, a.hasTag
+}
+
+// IsTestnet reports whether the address was flagged for test networks in its X-address form
+func (a *XrplAddress) IsTestnet() bool {
+	return a.testnet
+}
+
+// AccountId returns the account id of the address
+func (a *XrplAddress) AccountId() [XrplAccountIdLength]byte {
+	return a.account
+}
+
+// ClassicAddress returns the classic representation (r...) of the account, which does not carry the tag
+func (a *XrplAddress) ClassicAddress() string {
+	return base58.CheckEncodeAlphabet([]byte{xrplAccountVersion}, a.account[:], base58.RippleAlphabet)
+}
+
+// XAddress returns the X-address representation of the account and its tag, for the main or test networks
+func (a *XrplAddress) XAddress(testnet bool) string {
+	prefix := xrplXAddressMainnetPrefix
+	if testnet {
+		prefix = xrplXAddressTestnetPrefix
+	}
+	payload := make([]byte, 0, xrplXAddressPayloadLength)
+	payload = append(payload, a.account[:]...)
+	if a.hasTag {
+		payload = append(payload, 1)
+	} else {
+		payload = append(payload, 0)
+	}
+	payload = binary.LittleEndian.AppendUint64(payload, uint64(a.tag))
+	return base58.CheckEncodeAlphabet(prefix, payload, base58.RippleAlphabet)
+}
+
+// String returns the classic address when no tag is set, otherwise the X-address carrying the tag
+func (a *XrplAddress) String() string {
+	if a.hasTag {
+		return a.XAddress(a.testnet)
+	}
+	return a.ClassicAddress()
+}
+
+func (a *XrplAddress) Hex() string {
+	return hex.EncodeToString(a.Bytes())
+}
+
+// Bytes returns the account id, followed by the destination tag as big endian u32 when set
+func (a *XrplAddress) Bytes() []byte {
+	buf := make([]byte, XrplAccountIdLength, XrplAccountIdLength+XrplTagLength)
+	copy(buf, a.account[:])
+	if a.hasTag {
+		buf = binary.BigEndian.AppendUint32(buf, a.tag)
+	}
+	return buf
+}
+
+// Bytes32 returns the account id, preceded by the destination tag as big endian u32 and a flags byte telling
+// whether the tag is set, left padded with zeroes. Classic addresses are then the account id left padded with
+// zeroes. As for Equal, the network flag is not considered.
+func (a *XrplAddress) Bytes32() ([Bytes32Length]byte, error) {
+	var out [Bytes32Length]byte
+	if a.hasTag {
+		out[xrplBytes32FlagsOffset] |= xrplBytes32HasTag
+	}
+	binary.BigEndian.PutUint32(out[xrplBytes32FlagsOffset+1:], a.tag)
+	copy(out[Bytes32Length-XrplAccountIdLength:], a.account[:])
+	return out, nil
+}
+
+// Key returns the comparable representation of the address
+func (a *XrplAddress) Key() AddressKey {
+	return newAddressKey(a.Ecosystem(), a.Bytes())
+}
+
+func (a *XrplAddress) Length() int {
+	if a.hasTag {
+		return XrplAccountIdLength + XrplTagLength
+	}
+	return XrplAccountIdLength
+}
+
+func (a *XrplAddress) Ecosystem() chainid.Ecosystem {
+	return chainid.EcosystemXrpl
+}
+
+// Equal reports whether the two addresses have the same account and destination tag, regardless of the
+// network flag
+func (a1 *XrplAddress) Equal(a2 Address) bool {
+	if equal, ok := equalNil(a1, a2); ok {
+		return equal
+	}
+	if a2.Ecosystem() != a1.Ecosystem() {
+		return false
+	}
+	a2AsXrpl, ok := a2.(*XrplAddress)
+	if !ok {
+		return false
+	}
+	return a1.hasTag == a2AsXrpl.hasTag &&
+		a1.tag == a2AsXrpl.tag &&
+		bytes.Equal(a1.account[:], a2AsXrpl.account[:])
+}
@@ -0,0 +1,47 @@
+package chainid
+
+import "encoding/base64"
+
+const AlgorandGenesisHashLength = 32
+
+type AlgorandLChainId struct {
+	lChainId
+}
+
+// NewAlgorandLChainId generates a new Lombard Chain Id for an Algorand network given the base64 encoding of its
+// genesis hash, as returned by the `/v2/transactions/params` endpoint of algod. The resulting Lombard Chain Id
+// is the genesis hash with its MSB replaced by the ecosystem byte.
+func NewAlgorandLChainId(genesisHash string) (AlgorandLChainId, error) {
+	decoded, err := base64.StdEncoding.DecodeString(genesisHash)
+	if err != nil {
+		return AlgorandLChainId{}, NewErrLChainIdInvalid(err)
+	}
+	if len(decoded) != AlgorandGenesisHashLength {
+		return AlgorandLChainId{}, NewErrLength(AlgorandGenesisHashLength, len(decoded))
+	}
+	// swap MSB with our ecosystem id
+	decoded[0] = byte(EcosystemAlgorand)
+	innerChainId, err := newLChainId(decoded)
+	if err != nil {
+		return AlgorandLChainId{}, err
+	}
+	return AlgorandLChainId{lChainId: *innerChainId}, nil
+}
+
+// NewAlgorandMainnetLChainId returns the LChainId for the Algorand mainnet
+func NewAlgorandMainnetLChainId() AlgorandLChainId {
+	return AlgorandLChainId{
+		lChainId{
+			inner: [32]byte{byte(EcosystemAlgorand), 0x61, 0xc4, 0xd8, 0xfc, 0x1d, 0xbd, 0xde, 0xd2, 0xd7, 0x60, 0x4b, 0xe4, 0x56, 0x8e, 0x3f, 0x6d, 0x04, 0x19, 0x87, 0xac, 0x37, 0xbd, 0xe4, 0xb6, 0x20, 0xb5, 0xab, 0x39, 0x24, 0x8a, 0xdf},
+		},
+	}
+}
+
+// NewAlgorandTestnetLChainId returns the LChainId for the Algorand testnet
+func NewAlgorandTestnetLChainId() AlgorandLChainId {
+	return AlgorandLChainId{
+		lChainId{
+			inner: [32]byte{byte(EcosystemAlgorand), 0x63, 0xb5, 0x18, 0xa4, 0xb3, 0xc8, 0x4e, 0xc8, 0x10, 0xf2, 0x2d, 0x4f, 0x10, 0x81, 0xcb, 0x0f, 0x71, 0xf0, 0x59, 0xa7, 0xac, 0x20, 0xde, 0xc6, 0x2f, 0x7f, 0x70, 0xe5, 0x09, 0x3a, 0x22},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestAlgorandLChainId_NewAlgorandLChainId(t *testing.T) {
+	ch, err := chainid.NewAlgorandLChainId("wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=")
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, ch.Equal(chainid.NewAlgorandMainnetLChainId()))
+	common.EqualStrings(t, "algorand", ch.Ecosystem().String())
+	common.AssertTrue(t, ch.Ecosystem().IsSupported())
+
+	fromHex, err := chainid.NewLChainIdFromHex(ch.String())
+	common.AssertNoError(t, err)
+	_, ok := fromHex.(chainid.AlgorandLChainId)
+	common.AssertTrue(t, ok)
+}
+
+func TestAlgorandLChainId_InvalidGenesisHash(t *testing.T) {
+	// shorter hash
+	_, err := chainid.NewAlgorandLChainId("wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkk")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid, chainid.ErrLength)
+	// invalid base64
+	_, err = chainid.NewAlgorandLChainId("wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid)
+}
+
+// These tests verify that LChainId concrete types are usable as map keys.
+func TestAlgorandLChainId_AsMapKey(t *testing.T) {
+	// Use two distinct equal instances
+	a := chainid.NewAlgorandTestnetLChainId()
+	b, err := chainid.NewAlgorandLChainId("SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=")
+	common.AssertNoError(t, err)
+	c, err := chainid.NewLChainIdFromHex(a.String())
+	common.AssertNoError(t, err)
+
+	m := map[chainid.LChainId]string{}
+	m[a] = "ok"
+
+	// same key instance
+	common.EqualStrings(t, "ok", m[a])
+	// distinct but equal value should still map to the same bucket if comparable by value
+	common.EqualStrings(t, "ok", m[b])
+	common.EqualStrings(t, "ok", m[c])
+}
@@ -0,0 +1,32 @@
+package chainid
+
+// AptosLChainId is the LChainId of Aptos networks, whose least significant byte is the u8 chain id
+// of the network as defined by Aptos
+type AptosLChainId struct {
+	lChainId
+}
+
+// NewAptosLChainId returns the LChainId for the Aptos network with the given u8 chain id
+func NewAptosLChainId(id uint8) AptosLChainId {
+	var inner [ChainIdLength]byte
+	inner[0] = byte(EcosystemAptos)
+	inner[ChainIdLength-1] = id
+	return AptosLChainId{
+		lChainId{inner: inner},
+	}
+}
+
+// NewAptosMainnetLChainId returns the LChainId for the Aptos mainnet blockchain (1)
+func NewAptosMainnetLChainId() AptosLChainId {
+	return NewAptosLChainId(1)
+}
+
+// NewAptosTestnetLChainId returns the LChainId for the Aptos testnet blockchain (2)
+func NewAptosTestnetLChainId() AptosLChainId {
+	return NewAptosLChainId(2)
+}
+
+// ChainId returns the u8 chain id of the network as it is meant in the Aptos ecosystem
+func (c AptosLChainId) ChainId() uint8 {
+	return c.inner[ChainIdLength-1]
+}
@@ -0,0 +1,37 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestAptosLChainId_NewLChainIdFromHex(t *testing.T) {
+	ch, err := chainid.NewLChainIdFromHex("0x0500000000000000000000000000000000000000000000000000000000000001")
+	common.AssertNoError(t, err)
+	aptosCh, ok := ch.(chainid.AptosLChainId)
+	common.AssertTrue(t, ok)
+	common.AssertTrue(t, aptosCh.ChainId() == 1)
+	common.AssertTrue(t, chainid.NewAptosTestnetLChainId().ChainId() == 2)
+	common.EqualStrings(t, "aptos", ch.Ecosystem().String())
+	common.AssertTrue(t, ch.Ecosystem().IsSupported())
+}
+
+// These tests verify that LChainId concrete types are usable as map keys.
+func TestAptosLChainId_AsMapKey(t *testing.T) {
+	// Use two distinct equal instances
+	a := chainid.NewAptosMainnetLChainId()
+	b := chainid.NewAptosLChainId(a.ChainId())
+	c, err := chainid.NewLChainIdFromHex(a.String())
+	common.AssertNoError(t, err)
+
+	m := map[chainid.LChainId]string{}
+	m[a] = "ok"
+
+	// same key instance
+	common.EqualStrings(t, "ok", m[a])
+	// distinct but equal value should still map to the same bucket if comparable by value
+	common.EqualStrings(t, "ok", m[b])
+	common.EqualStrings(t, "ok", m[c])
+}
@@ -0,0 +1,64 @@
+package chainid
+
+import "encoding/binary"
+
+const (
+	// CardanoMainnetMagic is the network magic of the Cardano mainnet
+	CardanoMainnetMagic uint32 = 764824073
+	// CardanoPreprodMagic is the network magic of the Cardano preprod testnet
+	CardanoPreprodMagic uint32 = 1
+	// CardanoPreviewMagic is the network magic of the Cardano preview testnet
+	CardanoPreviewMagic uint32 = 2
+)
+
+const (
+	// CardanoMainnetNetworkId is the network id carried by mainnet addresses
+	CardanoMainnetNetworkId uint8 = 1
+	// CardanoTestnetNetworkId is the network id carried by addresses of any testnet
+	CardanoTestnetNetworkId uint8 = 0
+)
+
+// CardanoLChainId is the LChainId of Cardano networks, whose least significant 4 bytes are the network magic
+// of the network as a big endian unsigned 32 bits integer
+type CardanoLChainId struct {
+	lChainId
+}
+
+// NewCardanoLChainId returns the LChainId for the Cardano network with the given network magic
+func NewCardanoLChainId(networkMagic uint32) CardanoLChainId {
+	var inner [ChainIdLength]byte
+	inner[0] = byte(EcosystemCardano)
+	binary.BigEndian.PutUint32(inner[ChainIdLength-4:], networkMagic)
+	return CardanoLChainId{
+		lChainId{inner: inner},
+	}
+}
+
+// NewCardanoMainnetLChainId returns the LChainId for the Cardano mainnet (764824073)
+func NewCardanoMainnetLChainId() CardanoLChainId {
+	return NewCardanoLChainId(CardanoMainnetMagic)
+}
+
+// NewCardanoPreprodLChainId returns the LChainId for the Cardano preprod testnet (1)
+func NewCardanoPreprodLChainId() CardanoLChainId {
+	return NewCardanoLChainId(CardanoPreprodMagic)
+}
+
+// NewCardanoPreviewLChainId returns the LChainId for the Cardano preview testnet (2)
+func NewCardanoPreviewLChainId() CardanoLChainId {
+	return NewCardanoLChainId(CardanoPreviewMagic)
+}
+
+// NetworkMagic returns the network magic as it is meant in the Cardano ecosystem
+func (c CardanoLChainId) NetworkMagic() uint32 {
+	return binary.BigEndian.Uint32(c.inner[ChainIdLength-4:])
+}
+
+// NetworkId returns the network id that addresses of the network carry in their header, i.e. 1 for the mainnet
+// and 0 for any testnet
+func (c CardanoLChainId) NetworkId() uint8 {
+	if c.NetworkMagic() == CardanoMainnetMagic {
+		return CardanoMainnetNetworkId
+	}
+	return CardanoTestnetNetworkId
+}
@@ -0,0 +1,39 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestCardanoLChainId_NewLChainIdFromHex(t *testing.T) {
+	ch, err := chainid.NewLChainIdFromHex("0x0c0000000000000000000000000000000000000000000000000000002d964a09")
+	common.AssertNoError(t, err)
+	cardanoCh, ok := ch.(chainid.CardanoLChainId)
+	common.AssertTrue(t, ok)
+	common.AssertTrue(t, cardanoCh.NetworkMagic() == chainid.CardanoMainnetMagic)
+	common.AssertTrue(t, cardanoCh.NetworkId() == chainid.CardanoMainnetNetworkId)
+	common.AssertTrue(t, chainid.NewCardanoPreprodLChainId().NetworkId() == chainid.CardanoTestnetNetworkId)
+	common.AssertTrue(t, chainid.NewCardanoPreviewLChainId().NetworkMagic() == 2)
+	common.EqualStrings(t, "cardano", ch.Ecosystem().String())
+	common.AssertTrue(t, ch.Ecosystem().IsSupported())
+}
+
+// These tests verify that LChainId concrete types are usable as map keys.
+func TestCardanoLChainId_AsMapKey(t *testing.T) {
+	// Use two distinct equal instances
+	a := chainid.NewCardanoMainnetLChainId()
+	b := chainid.NewCardanoLChainId(a.NetworkMagic())
+	c, err := chainid.NewLChainIdFromHex(a.String())
+	common.AssertNoError(t, err)
+
+	m := map[chainid.LChainId]string{}
+	m[a] = "ok"
+
+	// same key instance
+	common.EqualStrings(t, "ok", m[a])
+	// distinct but equal value should still map to the same bucket if comparable by value
+	common.EqualStrings(t, "ok", m[b])
+	common.EqualStrings(t, "ok", m[c])
+}
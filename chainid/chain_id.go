@@ -18,47 +18,35 @@ type Ecosystem uint8
 // Supported Ecosystems. We let the following constants match the MSB of the chain Id
 // according to Lombard internal reference.
 const (
-	EcosystemEVM      Ecosystem = 0
-	EcosystemSui      Ecosystem = 1
-	EcosystemSolana   Ecosystem = 2
-	EcosystemCosmos   Ecosystem = 3
-	EcosystemStarknet Ecosystem = 4
-	EcosystemBitcoin  Ecosystem = 255
+	EcosystemEVM       Ecosystem = 0
+	EcosystemSui       Ecosystem = 1
+	EcosystemSolana    Ecosystem = 2
+	EcosystemCosmos    Ecosystem = 3
+	EcosystemStarknet  Ecosystem = 4
+	EcosystemAptos     Ecosystem = 5
+	EcosystemTon       Ecosystem = 6
+	EcosystemTron      Ecosystem = 7
+	EcosystemStellar   Ecosystem = 8
+	EcosystemXrpl      Ecosystem = 9
+	EcosystemSubstrate Ecosystem = 10
+	EcosystemNear      Ecosystem = 11
+	EcosystemCardano   Ecosystem = 12
+	EcosystemAlgorand  Ecosystem = 13
+	EcosystemBitcoin   Ecosystem = 255
 )
 
 func (t Ecosystem) String() string {
-	switch t {
-	case EcosystemEVM:
-		return "evm"
-	case EcosystemSui:
-		return "sui"
-	case EcosystemSolana:
-		return "solana"
-	case EcosystemCosmos:
-		return "cosmos"
-	case EcosystemStarknet:
-		return "starknet"
-	case EcosystemBitcoin:
-		return "bitcoin"
-	default:
-		return fmt.Sprintf("ecosystem %d", t)
+	if spec, ok := LookupEcosystem(t); ok {
+		return spec.Name
 	}
+	return fmt.Sprintf("ecosystem %d", t)
 }
 
 // IsSupported reports whether the Ecosystem is among the supported ones, which means a
-// specialized ecosystem type is available in the package
+// specialized ecosystem type is available in the package or has been registered with RegisterEcosystem
 func (t Ecosystem) IsSupported() bool {
-	switch t {
-	case EcosystemEVM:
-	case EcosystemSui:
-	case EcosystemSolana:
-	case EcosystemBitcoin:
-	case EcosystemCosmos:
-	case EcosystemStarknet:
-	default:
-		return false
-	}
-	return true
+	_, ok := LookupEcosystem(t)
+	return ok
 }
 
 func (t Ecosystem) ToEcosystemHexByte() string {
@@ -85,6 +73,9 @@ type LChainId interface {
 
 	// Equal reports whether a and be are the same
 	Equal(b LChainId) bool
+
+	// Environment returns the environment of the chain, EnvironmentUnknown if the chain is not known
+	Environment() Environment
 }
 
 // NewLChainId creates a new ChainId instance by accepting the bytes of the chain Id encoded
@@ -96,36 +87,12 @@ func NewLChainId(in []byte) (LChainId, error) {
     var out [ChainIdLength]byte
     copy(out[:], in)
     id := lChainId{inner: out}
-    switch id.Ecosystem() {
-	case EcosystemEVM:
-		return EVMLChainId{
-			lChainId: id,
-		}, nil
-	case EcosystemSui:
-		return SuiLChainId{
-			lChainId: id,
-		}, nil
-	case EcosystemBitcoin:
-		return BitcoinLChainId{
-			lChainId: id,
-		}, nil
-	case EcosystemSolana:
-		return SolanaLChainId{
-			lChainId: id,
-		}, nil
-	case EcosystemCosmos:
-		return CosmosLChainId{
-			lChainId: id,
-		}, nil
-	case EcosystemStarknet:
-		return StarknetLChainId{
-			lChainId: id,
-		}, nil
-	default:
-		return GenericLChainId{
-			lChainId: id,
-		}, nil
-	}
+    generic := GenericLChainId{lChainId: id}
+    spec, ok := LookupEcosystem(id.Ecosystem())
+    if !ok || spec.Wrap == nil {
+        return generic, nil
+    }
+    return spec.Wrap(generic), nil
 }
 
 // NewLChainIdFromHex creates a new ChainId instance by accepting an hex string of the chain Id.
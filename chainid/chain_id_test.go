@@ -167,6 +167,132 @@ func TestLChainId(t *testing.T) {
 			chainid.EcosystemStarknet,
 			func() chainid.LChainId { return chainid.NewStarknetSepoliaLChainId() },
 		},
+		{
+			"Aptos Mainnet",
+			"0x0500000000000000000000000000000000000000000000000000000000000001",
+			chainid.EcosystemAptos,
+			func() chainid.LChainId { return chainid.NewAptosMainnetLChainId() },
+		},
+		{
+			"Aptos Testnet",
+			"0x0500000000000000000000000000000000000000000000000000000000000002",
+			chainid.EcosystemAptos,
+			func() chainid.LChainId { return chainid.NewAptosTestnetLChainId() },
+		},
+		{
+			"TON Mainnet",
+			"0x06000000000000000000000000000000000000000000000000000000ffffff11",
+			chainid.EcosystemTon,
+			func() chainid.LChainId { return chainid.NewTonMainnetLChainId() },
+		},
+		{
+			"TON Testnet",
+			"0x06000000000000000000000000000000000000000000000000000000fffffffd",
+			chainid.EcosystemTon,
+			func() chainid.LChainId { return chainid.NewTonTestnetLChainId() },
+		},
+		{
+			"Tron Mainnet",
+			"0x070000000000000000000000000000000000000000000000000000002b6653dc",
+			chainid.EcosystemTron,
+			func() chainid.LChainId { return chainid.NewTronMainnetLChainId() },
+		},
+		{
+			"Tron Shasta",
+			"0x0700000000000000000000000000000000000000000000000000000094a9059e",
+			chainid.EcosystemTron,
+			func() chainid.LChainId { return chainid.NewTronShastaLChainId() },
+		},
+		{
+			"Tron Nile",
+			"0x07000000000000000000000000000000000000000000000000000000cd8690dc",
+			chainid.EcosystemTron,
+			func() chainid.LChainId { return chainid.NewTronNileLChainId() },
+		},
+		{
+			"Stellar Pubnet",
+			"0x08c33997544e3175d266bd022439b22cdb16508c01163f26e5cb2a3e1045a979",
+			chainid.EcosystemStellar,
+			func() chainid.LChainId { return chainid.NewStellarPubnetLChainId() },
+		},
+		{
+			"Stellar Testnet",
+			"0x08e0302d59844d32bdca915c8203dd44b33fbb7edc19051ea37abedf28ecd472",
+			chainid.EcosystemStellar,
+			func() chainid.LChainId { return chainid.NewStellarTestnetLChainId() },
+		},
+		{
+			"XRPL Mainnet",
+			"0x0900000000000000000000000000000000000000000000000000000000000000",
+			chainid.EcosystemXrpl,
+			func() chainid.LChainId { return chainid.NewXrplMainnetLChainId() },
+		},
+		{
+			"XRPL Testnet",
+			"0x0900000000000000000000000000000000000000000000000000000000000001",
+			chainid.EcosystemXrpl,
+			func() chainid.LChainId { return chainid.NewXrplTestnetLChainId() },
+		},
+		{
+			"Polkadot",
+			"0x0ab171bb158e2d3848fa23a9f1c25182fb8e20313b2c1eb49219da7a70ce90c3",
+			chainid.EcosystemSubstrate,
+			func() chainid.LChainId { return chainid.NewPolkadotLChainId() },
+		},
+		{
+			"Kusama",
+			"0x0aa8d493285c2df73290dfb7e61f870f17b41801197a149ca93654499ea3dafe",
+			chainid.EcosystemSubstrate,
+			func() chainid.LChainId { return chainid.NewKusamaLChainId() },
+		},
+		{
+			"Westend",
+			"0x0a43f23803ac50e8f6f8e62695d1ce9e4e1d68aa36c1cd2cfd15340213f3423e",
+			chainid.EcosystemSubstrate,
+			func() chainid.LChainId { return chainid.NewWestendLChainId() },
+		},
+		{
+			"NEAR Mainnet",
+			"0x0b2a3ebbd23b7cca0929441e6672e0c1023d9e30c96aae7cd458cec3508dbfb6",
+			chainid.EcosystemNear,
+			func() chainid.LChainId { return chainid.NewNearMainnetLChainId() },
+		},
+		{
+			"NEAR Testnet",
+			"0x0bfbce9f2416520733bacb370315d32b6b2c43d6097576df1c1222859d91eecc",
+			chainid.EcosystemNear,
+			func() chainid.LChainId { return chainid.NewNearTestnetLChainId() },
+		},
+		{
+			"Cardano Mainnet",
+			"0x0c0000000000000000000000000000000000000000000000000000002d964a09",
+			chainid.EcosystemCardano,
+			func() chainid.LChainId { return chainid.NewCardanoMainnetLChainId() },
+		},
+		{
+			"Cardano Preprod",
+			"0x0c00000000000000000000000000000000000000000000000000000000000001",
+			chainid.EcosystemCardano,
+			func() chainid.LChainId { return chainid.NewCardanoPreprodLChainId() },
+		},
+		{
+			"Cardano Preview",
+			"0x0c00000000000000000000000000000000000000000000000000000000000002",
+			chainid.EcosystemCardano,
+			func() chainid.LChainId { return chainid.NewCardanoPreviewLChainId() },
+		},
+		{
+			"Algorand Mainnet",
+			"0x0d61c4d8fc1dbdded2d7604be4568e3f6d041987ac37bde4b620b5ab39248adf",
+			chainid.EcosystemAlgorand,
+			func() chainid.LChainId { return chainid.NewAlgorandMainnetLChainId() },
+		},
+		{
+			"Algorand Testnet",
+			"0x0d63b518a4b3c84ec810f22d4f1081cb0f71f059a7ac20dec62f7f70e5093a22",
+			chainid.EcosystemAlgorand,
+			func() chainid.LChainId { return chainid.NewAlgorandTestnetLChainId() },
+		},
 		{
 			"Bitcoin",
 			"0xff0000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f",
@@ -179,6 +305,18 @@ func TestLChainId(t *testing.T) {
 			chainid.EcosystemBitcoin,
 			func() chainid.LChainId { return chainid.NewBitcoinSignetLChainId() },
 		},
+		{
+			"Litecoin",
+			"0xffa765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2",
+			chainid.EcosystemBitcoin,
+			func() chainid.LChainId { return chainid.NewLitecoinLChainId() },
+		},
+		{
+			"Dogecoin",
+			"0xff91e3dace36e2be3bf030a65679fe821aa1d6ef92e7c9902eb318182c355691",
+			chainid.EcosystemBitcoin,
+			func() chainid.LChainId { return chainid.NewDogecoinLChainId() },
+		},
 	}
 
 	for _, test := range tests {
@@ -342,6 +480,61 @@ func TestLChainIdFactories(t *testing.T) {
 			func(in string) (chainid.LChainId, error) { return chainid.NewCosmosLChainId(in) },
 			func() chainid.LChainId { return chainid.NewBabylonLChainId() },
 		},
+		{
+			"0x2b6653dc",
+			func(in string) (chainid.LChainId, error) { return chainid.NewTronLChainId(in) },
+			func() chainid.LChainId { return chainid.NewTronMainnetLChainId() },
+		},
+		{
+			"94a9059e",
+			func(in string) (chainid.LChainId, error) { return chainid.NewTronLChainId(in) },
+			func() chainid.LChainId { return chainid.NewTronShastaLChainId() },
+		},
+		{
+			"Public Global Stellar Network ; September 2015",
+			func(in string) (chainid.LChainId, error) { return chainid.NewStellarLChainId(in) },
+			func() chainid.LChainId { return chainid.NewStellarPubnetLChainId() },
+		},
+		{
+			"Test SDF Network ; September 2015",
+			func(in string) (chainid.LChainId, error) { return chainid.NewStellarLChainId(in) },
+			func() chainid.LChainId { return chainid.NewStellarTestnetLChainId() },
+		},
+		{
+			"0x91b171bb158e2d3848fa23a9f1c25182fb8e20313b2c1eb49219da7a70ce90c3",
+			func(in string) (chainid.LChainId, error) { return chainid.NewSubstrateLChainId(in) },
+			func() chainid.LChainId { return chainid.NewPolkadotLChainId() },
+		},
+		{
+			"0xb0a8d493285c2df73290dfb7e61f870f17b41801197a149ca93654499ea3dafe",
+			func(in string) (chainid.LChainId, error) { return chainid.NewSubstrateLChainId(in) },
+			func() chainid.LChainId { return chainid.NewKusamaLChainId() },
+		},
+		{
+			"0xe143f23803ac50e8f6f8e62695d1ce9e4e1d68aa36c1cd2cfd15340213f3423e",
+			func(in string) (chainid.LChainId, error) { return chainid.NewSubstrateLChainId(in) },
+			func() chainid.LChainId { return chainid.NewWestendLChainId() },
+		},
+		{
+			"mainnet",
+			func(in string) (chainid.LChainId, error) { return chainid.NewNearLChainId(in) },
+			func() chainid.LChainId { return chainid.NewNearMainnetLChainId() },
+		},
+		{
+			"testnet",
+			func(in string) (chainid.LChainId, error) { return chainid.NewNearLChainId(in) },
+			func() chainid.LChainId { return chainid.NewNearTestnetLChainId() },
+		},
+		{
+			"wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=",
+			func(in string) (chainid.LChainId, error) { return chainid.NewAlgorandLChainId(in) },
+			func() chainid.LChainId { return chainid.NewAlgorandMainnetLChainId() },
+		},
+		{
+			"SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=",
+			func(in string) (chainid.LChainId, error) { return chainid.NewAlgorandLChainId(in) },
+			func() chainid.LChainId { return chainid.NewAlgorandTestnetLChainId() },
+		},
 		{
 			"SN_MAIN",
 			func(in string) (chainid.LChainId, error) { return chainid.NewStarknetLChainIdFromName(in) },
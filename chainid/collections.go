@@ -0,0 +1,254 @@
+package chainid
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// ErrDuplicateChainId is returned when decoding a collection holding the same chain id more than once, possibly
+// with different encodings
+var ErrDuplicateChainId = fmt.Errorf("duplicate chain id")
+
+// Compare returns -1, 0 or +1 depending on whether a sorts before, equal to or after b. Chain ids are sorted by
+// ecosystem first and then by bytes, which is the order of FixedBytes since the ecosystem is the most significant
+// byte. A nil LChainId sorts before any other.
+func Compare(a, b LChainId) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	aBytes, bBytes := a.FixedBytes(), b.FixedBytes()
+	return bytes.Compare(aBytes[:], bBytes[:])
+}
+
+// ChainIdSet is a set of chain ids, normalized via FixedBytes so that chain ids built by different constructors
+// are the same element. Iteration is sorted according to Compare. The zero value is an empty set ready to use. A nil
+// LChainId is never an element of the set.
+type ChainIdSet struct {
+	m map[[ChainIdLength]byte]LChainId
+}
+
+// NewChainIdSet creates a new ChainIdSet holding the given chain ids
+func NewChainIdSet(ids ...LChainId) *ChainIdSet {
+	s := &ChainIdSet{}
+	for _, id := range ids {
+		s.Add(id)
+	}
+	return s
+}
+
+// Add adds the chain id to the set, reporting whether it was not already present. A nil LChainId is ignored.
+func (s *ChainIdSet) Add(id LChainId) bool {
+	if id == nil {
+		return false
+	}
+	if s.m == nil {
+		s.m = make(map[[ChainIdLength]byte]LChainId)
+	}
+	key := id.FixedBytes()
+	if _, ok := s.m[key]; ok {
+		return false
+	}
+	s.m[key] = id
+	return true
+}
+
+// Remove removes the chain id from the set, reporting whether it was present
+func (s *ChainIdSet) Remove(id LChainId) bool {
+	if id == nil {
+		return false
+	}
+	key := id.FixedBytes()
+	if _, ok := s.m[key]; !ok {
+		return false
+	}
+	delete(s.m, key)
+	return true
+}
+
+// Contains reports whether the chain id is in the set
+func (s *ChainIdSet) Contains(id LChainId) bool {
+	if id == nil {
+		return false
+	}
+	_, ok := s.m[id.FixedBytes()]
+	return ok
+}
+
+// Len returns the number of chain ids in the set
+func (s *ChainIdSet) Len() int {
+	return len(s.m)
+}
+
+// All returns an iterator over the chain ids of the set, sorted according to Compare
+func (s *ChainIdSet) All() iter.Seq[LChainId] {
+	return func(yield func(LChainId) bool) {
+		for _, key := range sortedKeys(s.m) {
+			if !yield(s.m[key]) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON encodes the set as a sorted array of chain ids in hex with leading 0x
+func (s ChainIdSet) MarshalJSON() ([]byte, error) {
+	out := make([]string, 0, s.Len())
+	for id := range s.All() {
+		out = append(out, id.String())
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes an array of chain ids in hex or notation, as accepted by ParseLChainId, replacing the
+// content of the set
+func (s *ChainIdSet) UnmarshalJSON(data []byte) error {
+	var in []string
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	decoded := ChainIdSet{}
+	for _, encoded := range in {
+		id, err := ParseLChainId(encoded)
+		if err != nil {
+			return err
+		}
+		if !decoded.Add(id) {
+			return NewErrLChainIdInvalid(fmt.Errorf("%w: %s", ErrDuplicateChainId, id))
+		}
+	}
+	*s = decoded
+	return nil
+}
+
+// chainIdEntry is an entry of ChainIdMap, keeping the chain id along with the value
+type chainIdEntry[V any] struct {
+	id    LChainId
+	value V
+}
+
+// ChainIdMap is a map from chain ids to values of type V, normalized via FixedBytes so that chain ids built by
+// different constructors are the same key. Iteration is sorted according to Compare. The zero value is an empty
+// map ready to use. A nil LChainId is never a key of the map.
+type ChainIdMap[V any] struct {
+	m map[[ChainIdLength]byte]chainIdEntry[V]
+}
+
+// NewChainIdMap creates a new empty ChainIdMap
+func NewChainIdMap[V any]() *ChainIdMap[V] {
+	return &ChainIdMap[V]{}
+}
+
+// Set associates the value to the chain id, replacing any previous value. A nil LChainId is ignored.
+func (m *ChainIdMap[V]) Set(id LChainId, value V) {
+	if id == nil {
+		return
+	}
+	if m.m == nil {
+		m.m = make(map[[ChainIdLength]byte]chainIdEntry[V])
+	}
+	m.m[id.FixedBytes()] = chainIdEntry[V]{id: id, value: value}
+}
+
+// Get returns the value associated to the chain id and whether it is present
+func (m *ChainIdMap[V]) Get(id LChainId) (V, bool) {
+	if id == nil {
+		var zero V
+		return zero, false
+	}
+	entry, ok := m.m[id.FixedBytes()]
+	return entry.value, ok
+}
+
+// Delete removes the chain id from the map, reporting whether it was present
+func (m *ChainIdMap[V]) Delete(id LChainId) bool {
+	if id == nil {
+		return false
+	}
+	key := id.FixedBytes()
+	if _, ok := m.m[key]; !ok {
+		return false
+	}
+	delete(m.m, key)
+	return true
+}
+
+// Len returns the number of chain ids in the map
+func (m *ChainIdMap[V]) Len() int {
+	return len(m.m)
+}
+
+// All returns an iterator over the chain ids and values of the map, sorted according to Compare
+func (m *ChainIdMap[V]) All() iter.Seq2[LChainId, V] {
+	return func(yield func(LChainId, V) bool) {
+		for _, key := range sortedKeys(m.m) {
+			entry := m.m[key]
+			if !yield(entry.id, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the chain ids of the map, sorted according to Compare
+func (m *ChainIdMap[V]) Keys() iter.Seq[LChainId] {
+	return func(yield func(LChainId) bool) {
+		for id := range m.All() {
+			if !yield(id) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON encodes the map as an object whose keys are the chain ids in hex with leading 0x, sorted according
+// to Compare
+func (m ChainIdMap[V]) MarshalJSON() ([]byte, error) {
+	// encoding/json sorts the keys, and the order of fixed length lowercase hex matches Compare
+	out := make(map[string]V, m.Len())
+	for id, value := range m.All() {
+		out[id.String()] = value
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes an object whose keys are chain ids in hex or notation, as accepted by ParseLChainId,
+// replacing the content of the map
+func (m *ChainIdMap[V]) UnmarshalJSON(data []byte) error {
+	var in map[string]V
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	decoded := ChainIdMap[V]{}
+	for encoded, value := range in {
+		id, err := ParseLChainId(encoded)
+		if err != nil {
+			return err
+		}
+		if _, ok := decoded.Get(id); ok {
+			return NewErrLChainIdInvalid(fmt.Errorf("%w: %s", ErrDuplicateChainId, id))
+		}
+		decoded.Set(id, value)
+	}
+	*m = decoded
+	return nil
+}
+
+// sortedKeys returns the keys of m in ascending order
+func sortedKeys[V any](m map[[ChainIdLength]byte]V) [][ChainIdLength]byte {
+	keys := make([][ChainIdLength]byte, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b [ChainIdLength]byte) int {
+		return bytes.Compare(a[:], b[:])
+	})
+	return keys
+}
@@ -0,0 +1,171 @@
+package chainid_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestCompare(t *testing.T) {
+	ethereum := chainid.NewEVMEthereumLChainId()
+	base := chainid.NewEVMBaseLChainId()
+	sui := chainid.NewSuiMainnetLChainId()
+
+	common.AssertTrue(t, chainid.Compare(ethereum, base) < 0)
+	common.AssertTrue(t, chainid.Compare(base, ethereum) > 0)
+	// ecosystem is compared first
+	common.AssertTrue(t, chainid.Compare(base, sui) < 0)
+	common.AssertTrue(t, chainid.Compare(sui, chainid.NewBitcoinLChainId()) < 0)
+
+	generic, err := chainid.NewLChainIdFromHex(ethereum.String())
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, chainid.Compare(ethereum, generic) == 0)
+	common.AssertTrue(t, chainid.Compare(nil, ethereum) < 0)
+	common.AssertTrue(t, chainid.Compare(ethereum, nil) > 0)
+	common.AssertTrue(t, chainid.Compare(nil, nil) == 0)
+}
+
+func TestChainIdSet(t *testing.T) {
+	ethereum := chainid.NewEVMEthereumLChainId()
+	base := chainid.NewEVMBaseLChainId()
+	sui := chainid.NewSuiMainnetLChainId()
+	bitcoin := chainid.NewBitcoinLChainId()
+
+	t.Run("should normalize chain ids of different constructors", func(t *testing.T) {
+		s := chainid.NewChainIdSet(sui, ethereum)
+		common.AssertTrue(t, s.Add(base))
+		generic, err := chainid.NewLChainIdFromHex(ethereum.Hex())
+		common.AssertNoError(t, err)
+		common.AssertFalse(t, s.Add(generic))
+		common.AssertTrue(t, s.Contains(generic))
+		common.AssertTrue(t, s.Len() == 3)
+
+		common.AssertTrue(t, s.Remove(generic))
+		common.AssertFalse(t, s.Contains(ethereum))
+		common.AssertFalse(t, s.Remove(ethereum))
+		common.AssertTrue(t, s.Len() == 2)
+	})
+
+	t.Run("should iterate in order", func(t *testing.T) {
+		var s chainid.ChainIdSet
+		for _, id := range []chainid.LChainId{bitcoin, sui, base, ethereum} {
+			s.Add(id)
+		}
+		ids := slices.Collect(s.All())
+		common.AssertTrue(t, slices.EqualFunc(ids, []chainid.LChainId{ethereum, base, sui, bitcoin}, chainid.LChainId.Equal))
+		// early break
+		for id := range s.All() {
+			common.AssertTrue(t, id.Equal(ethereum))
+			break
+		}
+	})
+
+	t.Run("should marshal json", func(t *testing.T) {
+		s := chainid.NewChainIdSet(sui, ethereum)
+		encoded, err := json.Marshal(struct {
+			Allowed chainid.ChainIdSet `json:"allowed"`
+		}{*s})
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, `{"allowed":["`+ethereum.String()+`","`+sui.String()+`"]}`, string(encoded))
+
+		var decoded struct {
+			Allowed chainid.ChainIdSet `json:"allowed"`
+		}
+		common.AssertNoError(t, json.Unmarshal(encoded, &decoded))
+		common.AssertTrue(t, decoded.Allowed.Len() == 2)
+		common.AssertTrue(t, decoded.Allowed.Contains(ethereum))
+		_, ok := slices.Collect(decoded.Allowed.All())[1].(chainid.SuiLChainId)
+		common.AssertTrue(t, ok)
+
+		err = json.Unmarshal([]byte(`["`+ethereum.String()+`","`+ethereum.Hex()+`"]`), &decoded.Allowed)
+		common.AssertError(t, err, chainid.ErrLChainIdInvalid, chainid.ErrDuplicateChainId)
+		err = json.Unmarshal([]byte(`["0x01"]`), &decoded.Allowed)
+		common.AssertError(t, err, chainid.ErrLChainIdInvalid, chainid.ErrLength)
+	})
+
+	t.Run("should ignore nil chain ids", func(t *testing.T) {
+		s := chainid.NewChainIdSet(nil, ethereum)
+		common.AssertTrue(t, s.Len() == 1)
+		common.AssertFalse(t, s.Add(nil))
+		common.AssertFalse(t, s.Contains(nil))
+		common.AssertFalse(t, s.Remove(nil))
+		common.AssertTrue(t, s.Len() == 1)
+
+		var empty chainid.ChainIdSet
+		common.AssertFalse(t, empty.Add(nil))
+		common.AssertFalse(t, empty.Contains(nil))
+		common.AssertTrue(t, empty.Len() == 0)
+	})
+}
+
+func TestChainIdMap(t *testing.T) {
+	ethereum := chainid.NewEVMEthereumLChainId()
+	base := chainid.NewEVMBaseLChainId()
+	sui := chainid.NewSuiMainnetLChainId()
+
+	t.Run("should set and get values", func(t *testing.T) {
+		m := chainid.NewChainIdMap[int]()
+		m.Set(sui, 1)
+		m.Set(ethereum, 2)
+		generic, err := chainid.NewLChainIdFromHex(ethereum.Hex())
+		common.AssertNoError(t, err)
+		m.Set(generic, 3)
+		common.AssertTrue(t, m.Len() == 2)
+		v, ok := m.Get(ethereum)
+		common.AssertTrue(t, ok && v == 3)
+		_, ok = m.Get(base)
+		common.AssertFalse(t, ok)
+
+		common.AssertTrue(t, m.Delete(ethereum))
+		common.AssertFalse(t, m.Delete(ethereum))
+		common.AssertTrue(t, m.Len() == 1)
+	})
+
+	t.Run("should iterate in order", func(t *testing.T) {
+		var m chainid.ChainIdMap[string]
+		m.Set(sui, "sui")
+		m.Set(base, "base")
+		m.Set(ethereum, "ethereum")
+		var values []string
+		for _, value := range m.All() {
+			values = append(values, value)
+		}
+		common.AssertTrue(t, slices.Equal([]string{"ethereum", "base", "sui"}, values))
+		keys := slices.Collect(m.Keys())
+		common.AssertTrue(t, slices.EqualFunc(keys, []chainid.LChainId{ethereum, base, sui}, chainid.LChainId.Equal))
+	})
+
+	t.Run("should marshal json", func(t *testing.T) {
+		var m chainid.ChainIdMap[uint64]
+		m.Set(sui, 1)
+		m.Set(base, 2)
+		m.Set(ethereum, 3)
+		encoded, err := json.Marshal(m)
+		common.AssertNoError(t, err)
+		expected := `{"` + ethereum.String() + `":3,"` + base.String() + `":2,"` + sui.String() + `":1}`
+		common.EqualStrings(t, expected, string(encoded))
+
+		var decoded chainid.ChainIdMap[uint64]
+		common.AssertNoError(t, json.Unmarshal(encoded, &decoded))
+		common.AssertTrue(t, decoded.Len() == 3)
+		v, ok := decoded.Get(base)
+		common.AssertTrue(t, ok && v == 2)
+
+		err = json.Unmarshal([]byte(`{"`+ethereum.String()+`":1,"`+ethereum.Hex()+`":2}`), &decoded)
+		common.AssertError(t, err, chainid.ErrDuplicateChainId)
+	})
+	t.Run("should ignore nil chain ids", func(t *testing.T) {
+		var m chainid.ChainIdMap[int]
+		m.Set(nil, 1)
+		common.AssertTrue(t, m.Len() == 0)
+		m.Set(ethereum, 2)
+		v, ok := m.Get(nil)
+		common.AssertFalse(t, ok)
+		common.AssertTrue(t, v == 0)
+		common.AssertFalse(t, m.Delete(nil))
+		common.AssertTrue(t, m.Len() == 1)
+	})
+}
@@ -3,6 +3,7 @@ package chainid
 import (
 	"crypto/sha256"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -35,6 +36,12 @@ func NewCosmosLChainId(chainId string) (CosmosLChainId, error) {
 	} else {
 		chainName = chainId
 	}
+	return newCosmosLChainIdFromName(chainName)
+}
+
+// newCosmosLChainIdFromName generates the Lombard Chain Id of a Cosmos chain given its chain name, without
+// attempting to strip any counter from it.
+func newCosmosLChainIdFromName(chainName string) (CosmosLChainId, error) {
 	hashedChainName := sha256.Sum256([]byte(chainName))
 	// Replace MSB with cosmos ecosystem byte
 	hashedChainName[0] = byte(EcosystemCosmos)
@@ -94,3 +101,69 @@ func NewBabylonLChainId() CosmosLChainId {
 		},
 	}
 }
+
+func NewEvmosLChainId() CosmosLChainId {
+	return CosmosLChainId{
+		lChainId: lChainId{
+			inner: [32]byte{0x03, 0x0d, 0x6c, 0x39, 0x53, 0x20, 0xe2, 0xd1, 0x57, 0xca, 0xbf, 0x74, 0xd1, 0x59, 0x77, 0xb3, 0x9c, 0x77, 0x23, 0x02, 0xfc, 0x10, 0xa8, 0xf5, 0x0e, 0x6f, 0xf0, 0xeb, 0x9d, 0x45, 0x49, 0x70},
+		},
+	}
+}
+
+func NewInjectiveLChainId() CosmosLChainId {
+	return CosmosLChainId{
+		lChainId: lChainId{
+			inner: [32]byte{0x03, 0x4e, 0x06, 0x22, 0x26, 0x47, 0x96, 0xc0, 0x40, 0x5a, 0xbf, 0xc6, 0xd0, 0x64, 0x0a, 0xa3, 0x01, 0x7d, 0x4f, 0x1e, 0xf3, 0x4b, 0xbf, 0x43, 0x61, 0x33, 0xb6, 0xa7, 0x02, 0x6f, 0x5c, 0x5e},
+		},
+	}
+}
+
+// CosmosChainInfo describes the properties of a known Cosmos chain
+type CosmosChainInfo struct {
+	// ChainName is the chain id without the trailing counter, as used to compute the Lombard Chain Id
+	ChainName string
+	// Bech32Prefix is the human readable part of account addresses on the chain
+	Bech32Prefix string
+	// EvmCompatible reports whether the chain runs an Ethermint-style EVM, where an account has the same 20 bytes
+	// both in its bech32 and in its 0x representation
+	EvmCompatible bool
+}
+
+// knownCosmosChains is the registry of the Cosmos chains whose properties are known to the library
+var knownCosmosChains = func() map[CosmosLChainId]CosmosChainInfo {
+	infos := []CosmosChainInfo{
+		{ChainName: "ledger-mainnet", Bech32Prefix: "lom"},
+		{ChainName: "ledger-testnet", Bech32Prefix: "lom"},
+		{ChainName: "ledger-devnet", Bech32Prefix: "lom"},
+		{ChainName: "osmosis", Bech32Prefix: "osmo"},
+		{ChainName: "cosmoshub", Bech32Prefix: "cosmos"},
+		{ChainName: "bbn", Bech32Prefix: "bbn"},
+		{ChainName: "evmos_9001", Bech32Prefix: "evmos", EvmCompatible: true},
+		{ChainName: "injective", Bech32Prefix: "inj", EvmCompatible: true},
+	}
+	out := make(map[CosmosLChainId]CosmosChainInfo, len(infos))
+	for _, info := range infos {
+		id, err := newCosmosLChainIdFromName(info.ChainName)
+		if err != nil {
+			panic(err)
+		}
+		out[id] = info
+	}
+	return out
+}()
+
+// Info returns the properties of the chain and whether the chain is among the known ones
+func (c CosmosLChainId) Info() (CosmosChainInfo, bool) {
+	info, ok := knownCosmosChains[c]
+	return info, ok
+}
+
+// KnownCosmosChains returns the properties of all the known Cosmos chains, sorted by chain name
+func KnownCosmosChains() []CosmosChainInfo {
+	out := make([]CosmosChainInfo, 0, len(knownCosmosChains))
+	for _, info := range knownCosmosChains {
+		out = append(out, info)
+	}
+	slices.SortFunc(out, func(a, b CosmosChainInfo) int { return strings.Compare(a.ChainName, b.ChainName) })
+	return out
+}
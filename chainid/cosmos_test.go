@@ -1,6 +1,7 @@
 package chainid_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/lombard-finance/ledger-utils/chainid"
@@ -114,3 +115,39 @@ func TestCosmosLChainId_AsMapKey(t *testing.T) {
 	common.EqualStrings(t, "ok", m[b])
 	common.EqualStrings(t, "ok", m[c])
 }
+
+func TestCosmosLChainId_Info(t *testing.T) {
+	info, ok := chainid.NewLombardLedgerLChainId().Info()
+	common.AssertTrue(t, ok)
+	common.EqualStrings(t, "lom", info.Bech32Prefix)
+	common.AssertFalse(t, info.EvmCompatible)
+
+	evmos, err := chainid.NewCosmosLChainId("evmos_9001-2")
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, evmos.Equal(chainid.NewEvmosLChainId()))
+	info, ok = evmos.Info()
+	common.AssertTrue(t, ok)
+	common.EqualStrings(t, "evmos", info.Bech32Prefix)
+	common.AssertTrue(t, info.EvmCompatible)
+
+	info, ok = chainid.NewInjectiveLChainId().Info()
+	common.AssertTrue(t, ok)
+	common.EqualStrings(t, "inj", info.Bech32Prefix)
+	common.AssertTrue(t, info.EvmCompatible)
+
+	unknown, err := chainid.NewCosmosLChainId("unknown-1")
+	common.AssertNoError(t, err)
+	_, ok = unknown.Info()
+	common.AssertFalse(t, ok)
+}
+
+func TestKnownCosmosChains(t *testing.T) {
+	chains := chainid.KnownCosmosChains()
+	common.AssertTrue(t, len(chains) == 8)
+	for i := 1; i < len(chains); i++ {
+		common.AssertTrue(t, chains[i-1].ChainName < chains[i].ChainName)
+	}
+	osmosis, ok := chainid.NewOsmosisLChainId().Info()
+	common.AssertTrue(t, ok)
+	common.AssertTrue(t, slices.Contains(chains, osmosis))
+}
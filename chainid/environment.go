@@ -0,0 +1,198 @@
+package chainid
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Environment classifies the network of a chain, e.g. to tell production chains apart from test ones
+type Environment byte
+
+const (
+	// EnvironmentUnknown is the environment of chains unknown to the library, which cannot be assumed to be either
+	// production or test chains
+	EnvironmentUnknown Environment = iota
+	// EnvironmentMainnet is the environment of production chains
+	EnvironmentMainnet
+	// EnvironmentTestnet is the environment of public test chains
+	EnvironmentTestnet
+	// EnvironmentDevnet is the environment of development chains, usually reset periodically
+	EnvironmentDevnet
+	// EnvironmentLocal is the environment of chains run locally, e.g. by Hardhat or Anvil
+	EnvironmentLocal
+)
+
+func (e Environment) String() string {
+	switch e {
+	case EnvironmentUnknown:
+		return "unknown"
+	case EnvironmentMainnet:
+		return "mainnet"
+	case EnvironmentTestnet:
+		return "testnet"
+	case EnvironmentDevnet:
+		return "devnet"
+	case EnvironmentLocal:
+		return "local"
+	default:
+		return fmt.Sprintf("environment %d", e)
+	}
+}
+
+// IsKnown reports whether the environment is not EnvironmentUnknown
+func (e Environment) IsKnown() bool {
+	return e != EnvironmentUnknown
+}
+
+// IsProduction reports whether the environment is EnvironmentMainnet
+func (e Environment) IsProduction() bool {
+	return e == EnvironmentMainnet
+}
+
+var ErrUnknownEnvironment = fmt.Errorf("unknown chain environment")
+var ErrEnvironmentMismatch = fmt.Errorf("chain environment mismatch")
+
+// evmLocalChainId returns the LChainId of an EVM chain run locally with the given chain id
+func evmLocalChainId(id int64) LChainId {
+	var inner [ChainIdLength]byte
+	big.NewInt(id).FillBytes(inner[1:])
+	return EVMLChainId{lChainId{inner: inner}}
+}
+
+// knownEnvironments is the environment of the chains known to the library
+var knownEnvironments = func() map[[ChainIdLength]byte]Environment {
+	byEnvironment := map[Environment][]LChainId{
+		EnvironmentMainnet: {
+			NewEVMEthereumLChainId(),
+			NewEVMBinanceSmartChainLChainId(),
+			NewEVMBaseLChainId(),
+			NewEVMSonicLChainId(),
+			NewEVMInkLChainId(),
+			NewEVMKatanaLChainId(),
+			NewEVMAvalancheLChainId(),
+			NewSuiMainnetLChainId(),
+			NewSolanaMainnetLChainId(),
+			NewLombardLedgerLChainId(),
+			NewOsmosisLChainId(),
+			NewCosmosHubLChainId(),
+			NewBabylonLChainId(),
+			NewEvmosLChainId(),
+			NewInjectiveLChainId(),
+			NewStarknetMainnetLChainId(),
+			NewAptosMainnetLChainId(),
+			NewTonMainnetLChainId(),
+			NewTronMainnetLChainId(),
+			NewStellarPubnetLChainId(),
+			NewXrplMainnetLChainId(),
+			NewPolkadotLChainId(),
+			NewKusamaLChainId(),
+			NewNearMainnetLChainId(),
+			NewCardanoMainnetLChainId(),
+			NewAlgorandMainnetLChainId(),
+			NewBitcoinLChainId(),
+			NewLitecoinLChainId(),
+			NewDogecoinLChainId(),
+		},
+		EnvironmentTestnet: {
+			NewEVMHoleskyLChainId(),
+			NewEVMSepoliaLChainId(),
+			NewEVMBinanceSmartChainTestnetLChainId(),
+			NewEVMBaseSepoliaLChainId(),
+			NewEVMSonicBlazeTestnetLChainId(),
+			NewEVMInkSepoliaLChainId(),
+			NewEVMKatanaTataraTestnetLChainId(),
+			NewEVMAvalancheFujiTestnetLChainId(),
+			NewSuiTestnetLChainId(),
+			NewLombardLedgerGastaldTestnetLChainId(),
+			NewStarknetSepoliaLChainId(),
+			NewAptosTestnetLChainId(),
+			NewTonTestnetLChainId(),
+			NewTronShastaLChainId(),
+			NewTronNileLChainId(),
+			NewStellarTestnetLChainId(),
+			NewXrplTestnetLChainId(),
+			NewWestendLChainId(),
+			NewNearTestnetLChainId(),
+			NewCardanoPreprodLChainId(),
+			NewCardanoPreviewLChainId(),
+			NewAlgorandTestnetLChainId(),
+			NewBitcoinSignetLChainId(),
+		},
+		EnvironmentDevnet: {
+			NewSolanaDevnetLChainId(),
+			NewLombardLedgerStagingDevnetLChainId(),
+			NewXrplDevnetLChainId(),
+		},
+		EnvironmentLocal: {
+			// Hardhat and Anvil
+			evmLocalChainId(31337),
+			// Ganache and Geth in dev mode
+			evmLocalChainId(1337),
+		},
+	}
+	out := make(map[[ChainIdLength]byte]Environment)
+	for env, ids := range byEnvironment {
+		for _, id := range ids {
+			out[id.FixedBytes()] = env
+		}
+	}
+	return out
+}()
+
+// Environment returns the environment of the chain, EnvironmentUnknown if the chain is not known to the library
+func (a lChainId) Environment() Environment {
+	return knownEnvironments[a.inner]
+}
+
+// Mismatch returns an error if a and b belong to incompatible environments, i.e. one of them is a production chain
+// and the other is not, or if the environment of any of them is unknown, including a nil LChainId. Test,
+// development and local chains are compatible with each other.
+func Mismatch(a, b LChainId) error {
+	if a == nil || b == nil {
+		return fmt.Errorf("%w: missing chain id", ErrUnknownEnvironment)
+	}
+	envA, envB := a.Environment(), b.Environment()
+	if !envA.IsKnown() {
+		return fmt.Errorf("%w: %s", ErrUnknownEnvironment, a)
+	}
+	if !envB.IsKnown() {
+		return fmt.Errorf("%w: %s", ErrUnknownEnvironment, b)
+	}
+	if envA.IsProduction() != envB.IsProduction() {
+		return fmt.Errorf("%w: %s is %s while %s is %s", ErrEnvironmentMismatch, a, envA, b, envB)
+	}
+	return nil
+}
+
+// RoutePolicy validates the routes between a source and a destination chain according to their environments, so
+// that production chains are never connected to test ones. The zero value rejects routes involving chains of
+// unknown environment.
+type RoutePolicy struct {
+	// AllowUnknown allows routes involving chains of unknown environment, e.g. chains of registered ecosystems
+	AllowUnknown bool
+	// SameEnvironment requires the chains to belong to the same environment, rejecting for instance routes from
+	// a testnet to a devnet
+	SameEnvironment bool
+}
+
+// Validate returns an error if the route from src to dst is not allowed by the policy. Missing chain ids are
+// rejected even if AllowUnknown is set.
+func (p RoutePolicy) Validate(src, dst LChainId) error {
+	if src == nil || dst == nil {
+		return fmt.Errorf("%w: missing chain id", ErrUnknownEnvironment)
+	}
+	err := Mismatch(src, dst)
+	switch {
+	case err == nil:
+	case p.AllowUnknown && !(src.Environment().IsKnown() && dst.Environment().IsKnown()):
+		return nil
+	default:
+		return err
+	}
+	if p.SameEnvironment && src.Environment() != dst.Environment() {
+		return fmt.Errorf(
+			"%w: %s is %s while %s is %s", ErrEnvironmentMismatch, src, src.Environment(), dst, dst.Environment(),
+		)
+	}
+	return nil
+}
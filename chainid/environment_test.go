@@ -0,0 +1,158 @@
+package chainid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestEnvironment(t *testing.T) {
+	t.Run("should classify the known chains", func(t *testing.T) {
+		tests := []struct {
+			chainId     chainid.LChainId
+			environment chainid.Environment
+		}{
+			{chainid.NewEVMEthereumLChainId(), chainid.EnvironmentMainnet},
+			{chainid.NewEVMSepoliaLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewEVMAvalancheFujiTestnetLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewSuiMainnetLChainId(), chainid.EnvironmentMainnet},
+			{chainid.NewSuiTestnetLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewSolanaMainnetLChainId(), chainid.EnvironmentMainnet},
+			{chainid.NewSolanaDevnetLChainId(), chainid.EnvironmentDevnet},
+			{chainid.NewLombardLedgerLChainId(), chainid.EnvironmentMainnet},
+			{chainid.NewLombardLedgerGastaldTestnetLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewLombardLedgerStagingDevnetLChainId(), chainid.EnvironmentDevnet},
+			{chainid.NewStarknetSepoliaLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewTonMainnetLChainId(), chainid.EnvironmentMainnet},
+			{chainid.NewTronNileLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewStellarPubnetLChainId(), chainid.EnvironmentMainnet},
+			{chainid.NewXrplDevnetLChainId(), chainid.EnvironmentDevnet},
+			{chainid.NewWestendLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewNearMainnetLChainId(), chainid.EnvironmentMainnet},
+			{chainid.NewCardanoPreviewLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewAlgorandTestnetLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewBitcoinLChainId(), chainid.EnvironmentMainnet},
+			{chainid.NewBitcoinSignetLChainId(), chainid.EnvironmentTestnet},
+			{chainid.NewDogecoinLChainId(), chainid.EnvironmentMainnet},
+		}
+		for _, tt := range tests {
+			t.Run(chainid.FormatLChainId(tt.chainId), func(t *testing.T) {
+				common.EqualStrings(t, tt.environment.String(), tt.chainId.Environment().String())
+			})
+		}
+	})
+
+	t.Run("should classify the same chain built by different constructors", func(t *testing.T) {
+		id, err := chainid.ParseLChainId("evm:1")
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "mainnet", id.Environment().String())
+
+		id, err = chainid.NewLChainIdFromHex(chainid.NewTronShastaLChainId().String())
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "testnet", id.Environment().String())
+	})
+
+	t.Run("should classify the local EVM chains", func(t *testing.T) {
+		for _, notation := range []string{"evm:31337", "evm:1337"} {
+			id, err := chainid.ParseLChainId(notation)
+			common.AssertNoError(t, err)
+			common.EqualStrings(t, "local", id.Environment().String())
+		}
+	})
+
+	t.Run("should classify the unknown chains as unknown", func(t *testing.T) {
+		for _, notation := range []string{"evm:424242", "cosmos:noble-1", "bitcoin:0x" + strings.Repeat("0", 62)} {
+			id, err := chainid.ParseLChainId(notation)
+			common.AssertNoError(t, err)
+			common.EqualStrings(t, "unknown", id.Environment().String())
+			common.AssertFalse(t, id.Environment().IsKnown())
+		}
+
+		generic, err := chainid.NewLChainIdFromHex("0xc8" + strings.Repeat("0", 62))
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, "unknown", generic.Environment().String())
+	})
+}
+
+func TestMismatch(t *testing.T) {
+	local, err := chainid.ParseLChainId("evm:31337")
+	common.AssertNoError(t, err)
+	unknown, err := chainid.ParseLChainId("evm:424242")
+	common.AssertNoError(t, err)
+
+	t.Run("should accept chains of compatible environments", func(t *testing.T) {
+		common.AssertNoError(t, chainid.Mismatch(chainid.NewEVMEthereumLChainId(), chainid.NewBitcoinLChainId()))
+		common.AssertNoError(t, chainid.Mismatch(chainid.NewEVMSepoliaLChainId(), chainid.NewBitcoinSignetLChainId()))
+		common.AssertNoError(t, chainid.Mismatch(chainid.NewEVMSepoliaLChainId(), chainid.NewSolanaDevnetLChainId()))
+		common.AssertNoError(t, chainid.Mismatch(local, chainid.NewSolanaDevnetLChainId()))
+	})
+
+	t.Run("should reject mainnet and non mainnet chains", func(t *testing.T) {
+		err := chainid.Mismatch(chainid.NewEVMEthereumLChainId(), chainid.NewBitcoinSignetLChainId())
+		common.AssertError(t, err, chainid.ErrEnvironmentMismatch)
+		err = chainid.Mismatch(chainid.NewSolanaDevnetLChainId(), chainid.NewBitcoinLChainId())
+		common.AssertError(t, err, chainid.ErrEnvironmentMismatch)
+		err = chainid.Mismatch(local, chainid.NewEVMEthereumLChainId())
+		common.AssertError(t, err, chainid.ErrEnvironmentMismatch)
+	})
+
+	t.Run("should reject unknown chains", func(t *testing.T) {
+		err := chainid.Mismatch(unknown, chainid.NewEVMEthereumLChainId())
+		common.AssertError(t, err, chainid.ErrUnknownEnvironment)
+		err = chainid.Mismatch(chainid.NewEVMSepoliaLChainId(), unknown)
+		common.AssertError(t, err, chainid.ErrUnknownEnvironment)
+	})
+
+	t.Run("should reject missing chains", func(t *testing.T) {
+		err := chainid.Mismatch(nil, chainid.NewEVMEthereumLChainId())
+		common.AssertError(t, err, chainid.ErrUnknownEnvironment)
+		err = chainid.Mismatch(chainid.NewEVMEthereumLChainId(), nil)
+		common.AssertError(t, err, chainid.ErrUnknownEnvironment)
+		err = chainid.Mismatch(nil, nil)
+		common.AssertError(t, err, chainid.ErrUnknownEnvironment)
+	})
+}
+
+func TestRoutePolicy(t *testing.T) {
+	unknown, err := chainid.ParseLChainId("evm:424242")
+	common.AssertNoError(t, err)
+
+	t.Run("should reject mainnet to testnet routes", func(t *testing.T) {
+		policies := []chainid.RoutePolicy{{}, {AllowUnknown: true}, {SameEnvironment: true}}
+		for _, p := range policies {
+			err := p.Validate(chainid.NewEVMEthereumLChainId(), chainid.NewSuiTestnetLChainId())
+			common.AssertError(t, err, chainid.ErrEnvironmentMismatch)
+			err = p.Validate(chainid.NewSuiTestnetLChainId(), chainid.NewEVMEthereumLChainId())
+			common.AssertError(t, err, chainid.ErrEnvironmentMismatch)
+		}
+	})
+
+	t.Run("should accept routes within the same environment", func(t *testing.T) {
+		p := chainid.RoutePolicy{SameEnvironment: true}
+		common.AssertNoError(t, p.Validate(chainid.NewEVMEthereumLChainId(), chainid.NewSuiMainnetLChainId()))
+		common.AssertNoError(t, p.Validate(chainid.NewEVMSepoliaLChainId(), chainid.NewSuiTestnetLChainId()))
+	})
+
+	t.Run("should reject routes across test environments only if required", func(t *testing.T) {
+		common.AssertNoError(t, chainid.RoutePolicy{}.Validate(chainid.NewEVMSepoliaLChainId(), chainid.NewSolanaDevnetLChainId()))
+		err := chainid.RoutePolicy{SameEnvironment: true}.Validate(chainid.NewEVMSepoliaLChainId(), chainid.NewSolanaDevnetLChainId())
+		common.AssertError(t, err, chainid.ErrEnvironmentMismatch)
+	})
+
+	t.Run("should reject unknown chains unless allowed", func(t *testing.T) {
+		err := chainid.RoutePolicy{}.Validate(unknown, chainid.NewEVMEthereumLChainId())
+		common.AssertError(t, err, chainid.ErrUnknownEnvironment)
+		common.AssertNoError(t, chainid.RoutePolicy{AllowUnknown: true}.Validate(unknown, chainid.NewEVMEthereumLChainId()))
+		common.AssertNoError(t, chainid.RoutePolicy{AllowUnknown: true}.Validate(chainid.NewEVMSepoliaLChainId(), unknown))
+	})
+	t.Run("should reject missing chains even if unknown are allowed", func(t *testing.T) {
+		for _, p := range []chainid.RoutePolicy{{}, {AllowUnknown: true}, {SameEnvironment: true}} {
+			err := p.Validate(nil, chainid.NewEVMEthereumLChainId())
+			common.AssertError(t, err, chainid.ErrUnknownEnvironment)
+			err = p.Validate(chainid.NewEVMEthereumLChainId(), nil)
+			common.AssertError(t, err, chainid.ErrUnknownEnvironment)
+		}
+	})
+}
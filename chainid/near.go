@@ -0,0 +1,52 @@
+package chainid
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+var ErrEmptyNearChainId = fmt.Errorf("cannot create Lombard chain Id from empty near chain id")
+
+// NearMainnetChainId is the chain id of the NEAR mainnet, as returned by the `status` JSON-RPC method
+const NearMainnetChainId = "mainnet"
+
+// NearTestnetChainId is the chain id of the NEAR testnet, as returned by the `status` JSON-RPC method
+const NearTestnetChainId = "testnet"
+
+type NearLChainId struct {
+	lChainId
+}
+
+// NewNearLChainId generates a new Lombard Chain Id for a NEAR network given its chain id, e.g. `mainnet`.
+// The resulting Lombard Chain Id is the sha256 hash of the chain id with its MSB replaced by the ecosystem byte.
+func NewNearLChainId(chainId string) (NearLChainId, error) {
+	if chainId == "" {
+		return NearLChainId{}, NewErrLChainIdInvalid(ErrEmptyNearChainId)
+	}
+	hashedChainId := sha256.Sum256([]byte(chainId))
+	// Replace MSB with near ecosystem byte
+	hashedChainId[0] = byte(EcosystemNear)
+	innerChainId, err := newLChainId(hashedChainId[:])
+	if err != nil {
+		return NearLChainId{}, err
+	}
+	return NearLChainId{lChainId: *innerChainId}, nil
+}
+
+// NewNearMainnetLChainId returns the LChainId for the NEAR mainnet
+func NewNearMainnetLChainId() NearLChainId {
+	return NearLChainId{
+		lChainId{
+			inner: [32]byte{byte(EcosystemNear), 0x2a, 0x3e, 0xbb, 0xd2, 0x3b, 0x7c, 0xca, 0x09, 0x29, 0x44, 0x1e, 0x66, 0x72, 0xe0, 0xc1, 0x02, 0x3d, 0x9e, 0x30, 0xc9, 0x6a, 0xae, 0x7c, 0xd4, 0x58, 0xce, 0xc3, 0x50, 0x8d, 0xbf, 0xb6},
+		},
+	}
+}
+
+// NewNearTestnetLChainId returns the LChainId for the NEAR testnet
+func NewNearTestnetLChainId() NearLChainId {
+	return NearLChainId{
+		lChainId{
+			inner: [32]byte{byte(EcosystemNear), 0xfb, 0xce, 0x9f, 0x24, 0x16, 0x52, 0x07, 0x33, 0xba, 0xcb, 0x37, 0x03, 0x15, 0xd3, 0x2b, 0x6b, 0x2c, 0x43, 0xd6, 0x09, 0x75, 0x76, 0xdf, 0x1c, 0x12, 0x22, 0x85, 0x9d, 0x91, 0xee, 0xcc},
+		},
+	}
+}
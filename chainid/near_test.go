@@ -0,0 +1,43 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestNearLChainId_NewNearLChainId(t *testing.T) {
+	ch, err := chainid.NewNearLChainId(chainid.NearMainnetChainId)
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, ch.Equal(chainid.NewNearMainnetLChainId()))
+	common.EqualStrings(t, "near", ch.Ecosystem().String())
+	common.AssertTrue(t, ch.Ecosystem().IsSupported())
+
+	_, err = chainid.NewNearLChainId("")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid, chainid.ErrEmptyNearChainId)
+
+	fromHex, err := chainid.NewLChainIdFromHex(ch.String())
+	common.AssertNoError(t, err)
+	_, ok := fromHex.(chainid.NearLChainId)
+	common.AssertTrue(t, ok)
+}
+
+// These tests verify that LChainId concrete types are usable as map keys.
+func TestNearLChainId_AsMapKey(t *testing.T) {
+	// Use two distinct equal instances
+	a := chainid.NewNearTestnetLChainId()
+	b, err := chainid.NewNearLChainId(chainid.NearTestnetChainId)
+	common.AssertNoError(t, err)
+	c, err := chainid.NewLChainIdFromHex(a.String())
+	common.AssertNoError(t, err)
+
+	m := map[chainid.LChainId]string{}
+	m[a] = "ok"
+
+	// same key instance
+	common.EqualStrings(t, "ok", m[a])
+	// distinct but equal value should still map to the same bucket if comparable by value
+	common.EqualStrings(t, "ok", m[b])
+	common.EqualStrings(t, "ok", m[c])
+}
@@ -0,0 +1,317 @@
+package chainid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// NotationSeparator separates the ecosystem from the native id in the notation of chain ids, e.g. `evm:1`
+const NotationSeparator = ":"
+
+// ErrInvalidNotation is returned when a chain id notation cannot be parsed
+var ErrInvalidNotation = fmt.Errorf("invalid chain id notation")
+
+// notation describes how the chain ids of an ecosystem are represented by their native id
+type notation struct {
+	// parse creates the chain id from the native id
+	parse func(native string) (LChainId, error)
+	// format returns the native id of the chain id, if it can be represented. When nil, only the chain ids of the
+	// known native ids can be represented.
+	format func(id LChainId) (string, bool)
+	// known are the native ids of the known chains, used by format when nil
+	known func() []string
+}
+
+// notations are the native notations of the built-in ecosystems. Chain ids of other ecosystems, or that cannot be
+// represented by their native id, use the raw notation `<ecosystem>:0x<hex>` of the bytes after the ecosystem.
+var notations = map[Ecosystem]notation{
+	EcosystemEVM: {
+		parse:  parseDecimalNotation(EcosystemEVM),
+		format: formatDecimalNotation,
+	},
+	EcosystemSui: {
+		parse: func(native string) (LChainId, error) { return NewSuiLChainId(native) },
+		format: func(id LChainId) (string, bool) {
+			inner := id.FixedBytes()
+			if !isZero(inner[1 : ChainIdLength-SuiIdentifierLength]) {
+				return "", false
+			}
+			return hex.EncodeToString(inner[ChainIdLength-SuiIdentifierLength:]), true
+		},
+	},
+	EcosystemSolana: {
+		parse: func(native string) (LChainId, error) { return NewSolanaLChainId(native) },
+		known: func() []string {
+			return []string{"5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d", "EtWTRABZaYq6iMfeYKouRu166VU2xqa1wcaWoxPkrZBG"}
+		},
+	},
+	EcosystemCosmos: {
+		parse: parseCosmosNotation,
+		known: func() []string {
+			var out []string
+			for _, info := range KnownCosmosChains() {
+				out = append(out, info.ChainName)
+			}
+			return out
+		},
+	},
+	EcosystemStarknet: {
+		parse:  parseStarknetNotation,
+		format: formatStarknetNotation,
+	},
+	EcosystemAptos: {
+		parse: func(native string) (LChainId, error) {
+			id, err := strconv.ParseUint(native, 10, 8)
+			if err != nil {
+				return nil, NewErrLChainIdInvalid(err)
+			}
+			return NewAptosLChainId(uint8(id)), nil
+		},
+		format: func(id LChainId) (string, bool) {
+			inner := id.FixedBytes()
+			if !isZero(inner[1 : ChainIdLength-1]) {
+				return "", false
+			}
+			return strconv.FormatUint(uint64(inner[ChainIdLength-1]), 10), true
+		},
+	},
+	EcosystemTon: {
+		parse: func(native string) (LChainId, error) {
+			globalId, err := strconv.ParseInt(native, 10, 32)
+			if err != nil {
+				return nil, NewErrLChainIdInvalid(err)
+			}
+			return NewTonLChainId(int32(globalId)), nil
+		},
+		format: func(id LChainId) (string, bool) {
+			value, ok := uint32Notation(id)
+			return strconv.FormatInt(int64(int32(value)), 10), ok
+		},
+	},
+	EcosystemTron: {
+		parse:  parseDecimalNotation(EcosystemTron),
+		format: formatDecimalNotation,
+	},
+	EcosystemStellar: {
+		parse: func(native string) (LChainId, error) { return NewStellarLChainId(native) },
+		known: func() []string { return []string{StellarPubnetPassphrase, StellarTestnetPassphrase} },
+	},
+	EcosystemXrpl: {
+		parse: parseUint32Notation(func(v uint32) LChainId { return NewXrplLChainId(v) }),
+		format: func(id LChainId) (string, bool) {
+			value, ok := uint32Notation(id)
+			return strconv.FormatUint(uint64(value), 10), ok
+		},
+	},
+	EcosystemSubstrate: {
+		parse: func(native string) (LChainId, error) { return NewSubstrateLChainId(native) },
+		known: func() []string {
+			return []string{
+				"91b171bb158e2d3848fa23a9f1c25182fb8e20313b2c1eb49219da7a70ce90c3",
+				"b0a8d493285c2df73290dfb7e61f870f17b41801197a149ca93654499ea3dafe",
+				"e143f23803ac50e8f6f8e62695d1ce9e4e1d68aa36c1cd2cfd15340213f3423e",
+			}
+		},
+	},
+	EcosystemNear: {
+		parse: func(native string) (LChainId, error) { return NewNearLChainId(native) },
+		known: func() []string { return []string{NearMainnetChainId, NearTestnetChainId} },
+	},
+	EcosystemCardano: {
+		parse: parseUint32Notation(func(v uint32) LChainId { return NewCardanoLChainId(v) }),
+		format: func(id LChainId) (string, bool) {
+			value, ok := uint32Notation(id)
+			return strconv.FormatUint(uint64(value), 10), ok
+		},
+	},
+	EcosystemAlgorand: {
+		parse: func(native string) (LChainId, error) { return NewAlgorandLChainId(native) },
+		known: func() []string {
+			return []string{"wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=", "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI="}
+		},
+	},
+	EcosystemBitcoin: {
+		parse: func(native string) (LChainId, error) { return NewUtxoLChainId(native) },
+		known: func() []string {
+			return []string{
+				"000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f",
+				"00000008819873e925422c1ff0f99f7cc9bbb232af63a077a480a3633bee1ef6",
+				"12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2",
+				"1a91e3dace36e2be3bf030a65679fe821aa1d6ef92e7c9902eb318182c355691",
+			}
+		},
+	},
+}
+
+// knownNativeIds maps the chain ids of the known native ids back to them, for the notations without format
+var knownNativeIds = func() map[[ChainIdLength]byte]string {
+	out := make(map[[ChainIdLength]byte]string)
+	for _, n := range notations {
+		if n.known == nil {
+			continue
+		}
+		for _, native := range n.known() {
+			id, err := n.parse(native)
+			if err != nil {
+				panic(err)
+			}
+			out[id.FixedBytes()] = native
+		}
+	}
+	return out
+}()
+
+// FormatLChainId returns the notation `<ecosystem>:<native id>` of the chain id, e.g. `evm:1`, `sui:35834a8a`,
+// `starknet:SN_MAIN` or `cosmos:osmosis`. Native ids are:
+//   - the decimal chain id for EVM and Tron chains, the u8 chain id for Aptos, the global id for TON, the network
+//     id for XRP Ledger and the network magic for Cardano
+//   - the hex identifier for Sui and the short string for Starknet
+//   - for the known chains only, the base58 genesis hash for Solana, the chain name for Cosmos, the passphrase for
+//     Stellar, the hex genesis hash for Substrate and Bitcoin-like chains, the chain id for NEAR and the base64
+//     genesis hash for Algorand
+//
+// Chain ids that cannot be represented by their native id use the raw notation `<ecosystem>:0x<hex>`, made of the
+// 31 bytes after the ecosystem, while chain ids of unsupported ecosystems are returned in hex. The notation is
+// parsed back to the same bytes by ParseLChainId.
+func FormatLChainId(id LChainId) string {
+	e := id.Ecosystem()
+	if !e.IsSupported() {
+		return id.String()
+	}
+	if native, ok := formatNative(id); ok {
+		return e.String() + NotationSeparator + native
+	}
+	inner := id.FixedBytes()
+	return e.String() + NotationSeparator + "0x" + hex.EncodeToString(inner[1:])
+}
+
+func formatNative(id LChainId) (string, bool) {
+	n, ok := notations[id.Ecosystem()]
+	if !ok {
+		return "", false
+	}
+	if n.format != nil {
+		return n.format(id)
+	}
+	native, ok := knownNativeIds[id.FixedBytes()]
+	return native, ok
+}
+
+// ParseLChainId creates a new LChainId from either its hex encoding, as accepted by NewLChainIdFromHex, or its
+// notation `<ecosystem>:<native id>` as returned by FormatLChainId. The ecosystem is looked up by name, so that
+// ecosystems registered with RegisterEcosystem are accepted in the raw notation.
+func ParseLChainId(s string) (LChainId, error) {
+	name, native, found := strings.Cut(s, NotationSeparator)
+	if !found {
+		return NewLChainIdFromHex(s)
+	}
+	e, ok := LookupEcosystemByName(name)
+	if !ok {
+		return nil, NewErrLChainIdInvalid(fmt.Errorf("%w: unknown ecosystem %q", ErrInvalidNotation, name))
+	}
+	var id LChainId
+	var err error
+	if raw, isRaw := strings.CutPrefix(native, "0x"); isRaw && len(raw) == ChainIdAvailableLength*2 {
+		id, err = NewLChainIdFromHex(e.ToEcosystemHexByte() + raw)
+	} else if n, ok := notations[e]; ok {
+		id, err = n.parse(native)
+	} else {
+		return nil, NewErrLChainIdInvalid(fmt.Errorf("%w: %s supports the raw notation only", ErrInvalidNotation, e))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+func isZero(b []byte) bool {
+	return bytes.Count(b, []byte{0}) == len(b)
+}
+
+// uint32Notation returns the least significant 4 bytes of the chain id as a big endian unsigned integer, and
+// whether the other bytes are zeroes
+func uint32Notation(id LChainId) (uint32, bool) {
+	inner := id.FixedBytes()
+	if !isZero(inner[1 : ChainIdLength-4]) {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(inner[ChainIdLength-4:]), true
+}
+
+func parseUint32Notation(newLChainId func(uint32) LChainId) func(string) (LChainId, error) {
+	return func(native string) (LChainId, error) {
+		value, err := strconv.ParseUint(native, 10, 32)
+		if err != nil {
+			return nil, NewErrLChainIdInvalid(err)
+		}
+		return newLChainId(uint32(value)), nil
+	}
+}
+
+func formatDecimalNotation(id LChainId) (string, bool) {
+	inner := id.FixedBytes()
+	return new(big.Int).SetBytes(inner[1:]).String(), true
+}
+
+func parseDecimalNotation(e Ecosystem) func(string) (LChainId, error) {
+	return func(native string) (LChainId, error) {
+		if native == "" || strings.Trim(native, "0123456789") != "" {
+			return nil, NewErrLChainIdInvalid(fmt.Errorf("%w: %q is not a decimal chain id", ErrInvalidNotation, native))
+		}
+		value, _ := new(big.Int).SetString(native, 10)
+		if value.BitLen() > ChainIdAvailableLength*8 {
+			return nil, NewMaxErrLength(ChainIdAvailableLength, (value.BitLen()+7)/8)
+		}
+		var inner [ChainIdLength]byte
+		inner[0] = byte(e)
+		value.FillBytes(inner[1:])
+		return NewLChainId(inner[:])
+	}
+}
+
+func parseCosmosNotation(native string) (LChainId, error) {
+	for _, info := range KnownCosmosChains() {
+		if info.ChainName == native {
+			return newCosmosLChainIdFromName(native)
+		}
+	}
+	// chain ids are accepted as well, e.g. `cosmoshub-4`
+	return NewCosmosLChainId(native)
+}
+
+// isShortString reports whether b is a valid Starknet short string, i.e. made of printable ASCII chars
+func isShortString(b []byte) bool {
+	if len(b) == 0 || len(b) > ChainIdAvailableLength {
+		return false
+	}
+	for _, c := range b {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func parseStarknetNotation(native string) (LChainId, error) {
+	if !isShortString([]byte(native)) {
+		return nil, NewErrLChainIdInvalid(fmt.Errorf("%w: %q is not a short string", ErrInvalidNotation, native))
+	}
+	var inner [ChainIdLength]byte
+	inner[0] = byte(EcosystemStarknet)
+	copy(inner[ChainIdLength-len(native):], native)
+	return NewLChainId(inner[:])
+}
+
+func formatStarknetNotation(id LChainId) (string, bool) {
+	inner := id.FixedBytes()
+	shortString := bytes.TrimLeft(inner[1:], "\x00")
+	if !isShortString(shortString) {
+		return "", false
+	}
+	return string(shortString), true
+}
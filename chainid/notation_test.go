@@ -0,0 +1,141 @@
+package chainid_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestLChainIdNotation(t *testing.T) {
+	t.Run("should format and parse the native ids", func(t *testing.T) {
+		tests := []struct {
+			chainId  chainid.LChainId
+			notation string
+		}{
+			{chainid.NewEVMEthereumLChainId(), "evm:1"},
+			{chainid.NewEVMSepoliaLChainId(), "evm:11155111"},
+			{chainid.NewEVMBaseLChainId(), "evm:8453"},
+			{chainid.NewSuiMainnetLChainId(), "sui:35834a8a"},
+			{chainid.NewSuiTestnetLChainId(), "sui:4c78adac"},
+			{chainid.NewSolanaMainnetLChainId(), "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d"},
+			{chainid.NewSolanaDevnetLChainId(), "solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1wcaWoxPkrZBG"},
+			{chainid.NewLombardLedgerLChainId(), "cosmos:ledger-mainnet"},
+			{chainid.NewOsmosisLChainId(), "cosmos:osmosis"},
+			{chainid.NewEvmosLChainId(), "cosmos:evmos_9001"},
+			{chainid.NewStarknetMainnetLChainId(), "starknet:SN_MAIN"},
+			{chainid.NewStarknetSepoliaLChainId(), "starknet:SN_SEPOLIA"},
+			{chainid.NewAptosMainnetLChainId(), "aptos:1"},
+			{chainid.NewTonMainnetLChainId(), "ton:-239"},
+			{chainid.NewTonTestnetLChainId(), "ton:-3"},
+			{chainid.NewTronMainnetLChainId(), "tron:728126428"},
+			{chainid.NewStellarPubnetLChainId(), "stellar:" + chainid.StellarPubnetPassphrase},
+			{chainid.NewXrplTestnetLChainId(), "xrpl:1"},
+			{chainid.NewPolkadotLChainId(), "substrate:91b171bb158e2d3848fa23a9f1c25182fb8e20313b2c1eb49219da7a70ce90c3"},
+			{chainid.NewKusamaLChainId(), "substrate:b0a8d493285c2df73290dfb7e61f870f17b41801197a149ca93654499ea3dafe"},
+			{chainid.NewWestendLChainId(), "substrate:e143f23803ac50e8f6f8e62695d1ce9e4e1d68aa36c1cd2cfd15340213f3423e"},
+			{chainid.NewNearMainnetLChainId(), "near:mainnet"},
+			{chainid.NewCardanoMainnetLChainId(), "cardano:764824073"},
+			{chainid.NewAlgorandTestnetLChainId(), "algorand:SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI="},
+			{chainid.NewBitcoinLChainId(), "bitcoin:000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"},
+			{chainid.NewBitcoinSignetLChainId(), "bitcoin:00000008819873e925422c1ff0f99f7cc9bbb232af63a077a480a3633bee1ef6"},
+			{chainid.NewLitecoinLChainId(), "bitcoin:12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2"},
+			{chainid.NewDogecoinLChainId(), "bitcoin:1a91e3dace36e2be3bf030a65679fe821aa1d6ef92e7c9902eb318182c355691"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.notation, func(t *testing.T) {
+				common.EqualStrings(t, tt.notation, chainid.FormatLChainId(tt.chainId))
+				parsed, err := chainid.ParseLChainId(tt.notation)
+				common.AssertNoError(t, err)
+				common.AssertTrue(t, parsed == tt.chainId)
+				// hex is still accepted
+				parsed, err = chainid.ParseLChainId(tt.chainId.String())
+				common.AssertNoError(t, err)
+				common.AssertTrue(t, parsed == tt.chainId)
+			})
+		}
+	})
+
+	t.Run("should accept other forms of the native ids", func(t *testing.T) {
+		tests := []struct {
+			notation string
+			expected chainid.LChainId
+		}{
+			{"sui:0x35834a8a", chainid.NewSuiMainnetLChainId()},
+			{"cosmos:cosmoshub-4", chainid.NewCosmosHubLChainId()},
+			{"evm:0x" + chainid.NewEVMEthereumLChainId().Hex()[2:], chainid.NewEVMEthereumLChainId()},
+			{"bitcoin:0x000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", chainid.NewBitcoinLChainId()},
+		}
+		for _, tt := range tests {
+			parsed, err := chainid.ParseLChainId(tt.notation)
+			common.AssertNoError(t, err)
+			common.AssertTrue(t, parsed == tt.expected)
+		}
+	})
+
+	t.Run("should fall back to the raw notation", func(t *testing.T) {
+		unknownSolana, err := chainid.NewSolanaLChainId("4uhcVJyU9pJkvQyS88uRDiswHXSCkY3zQawwpjk2NsNY")
+		common.AssertNoError(t, err)
+		unknownCosmos, err := chainid.NewCosmosLChainId("noble-1")
+		common.AssertNoError(t, err)
+		wideSui, err := chainid.NewLChainIdFromHex("0x010000000000000000000000000000000000000000000000000000aa35834a8a")
+		common.AssertNoError(t, err)
+		wideStarknet, err := chainid.NewStarknetLChainId("0x534e0a4d41494e")
+		common.AssertNoError(t, err)
+		for _, id := range []chainid.LChainId{unknownSolana, unknownCosmos, wideSui, wideStarknet} {
+			notation := chainid.FormatLChainId(id)
+			common.EqualStrings(t, id.Ecosystem().String()+":0x"+id.Hex()[2:], notation)
+			parsed, err := chainid.ParseLChainId(notation)
+			common.AssertNoError(t, err)
+			common.AssertTrue(t, parsed == id)
+			common.AssertTrue(t, reflect.TypeOf(parsed) == reflect.TypeOf(id))
+		}
+
+		// unsupported ecosystems are formatted in hex
+		generic, err := chainid.NewLChainIdFromHex("0xc8" + strings.Repeat("11", chainid.ChainIdAvailableLength))
+		common.AssertNoError(t, err)
+		common.EqualStrings(t, generic.String(), chainid.FormatLChainId(generic))
+	})
+
+	t.Run("should accept registered ecosystems in the raw notation", func(t *testing.T) {
+		ecosystem := chainid.Ecosystem(120)
+		common.AssertNoError(t, chainid.RegisterEcosystem(ecosystem, chainid.EcosystemSpec{Name: "notation"}))
+		id, err := chainid.NewLChainIdFromHex("0x78" + strings.Repeat("22", chainid.ChainIdAvailableLength))
+		common.AssertNoError(t, err)
+		notation := chainid.FormatLChainId(id)
+		common.EqualStrings(t, "notation:0x"+strings.Repeat("22", chainid.ChainIdAvailableLength), notation)
+		parsed, err := chainid.ParseLChainId(notation)
+		common.AssertNoError(t, err)
+		common.AssertTrue(t, id.Equal(parsed))
+
+		_, err = chainid.ParseLChainId("notation:1")
+		common.AssertError(t, err, chainid.ErrLChainIdInvalid, chainid.ErrInvalidNotation)
+	})
+
+	t.Run("should reject invalid notations", func(t *testing.T) {
+		tests := []struct {
+			notation string
+			errs     []error
+		}{
+			{"unknown:1", []error{chainid.ErrLChainIdInvalid, chainid.ErrInvalidNotation}},
+			{"evm:", []error{chainid.ErrLChainIdInvalid, chainid.ErrInvalidNotation}},
+			{"evm:-1", []error{chainid.ErrLChainIdInvalid, chainid.ErrInvalidNotation}},
+			{"evm:0x1", []error{chainid.ErrLChainIdInvalid, chainid.ErrInvalidNotation}},
+			{"evm:" + "9" + strings.Repeat("0", 80), []error{chainid.ErrLChainIdInvalid, chainid.ErrLength}},
+			{"sui:35834a", []error{chainid.ErrLChainIdInvalid, chainid.ErrLength}},
+			{"starknet:SN MAIN", []error{chainid.ErrLChainIdInvalid, chainid.ErrInvalidNotation}},
+			{"starknet:" + strings.Repeat("A", 32), []error{chainid.ErrLChainIdInvalid, chainid.ErrInvalidNotation}},
+			{"aptos:256", []error{chainid.ErrLChainIdInvalid}},
+			{"ton:2147483648", []error{chainid.ErrLChainIdInvalid}},
+			{"cosmos:", []error{chainid.ErrInvalidCosmosChainId}},
+			{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZ", []error{chainid.ErrLChainIdInvalid, chainid.ErrLength}},
+			{"bitcoin:0x00", []error{chainid.ErrLChainIdInvalid, chainid.ErrLength}},
+		}
+		for _, tt := range tests {
+			_, err := chainid.ParseLChainId(tt.notation)
+			common.AssertError(t, err, tt.errs...)
+		}
+	})
+}
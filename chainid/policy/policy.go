@@ -0,0 +1,381 @@
+// Package policy implements declarative route policies, telling which transfers from a source chain to a
+// destination chain are allowed and to which recipients, so that services can share the same allowlist.
+//
+// Policies are loaded from JSON, e.g.
+//
+//	{
+//	  "environment": {"allowUnknown": false, "sameEnvironment": false},
+//	  "routes": [
+//	    {"source": "evm:1", "destination": "evm:*", "recipient": {"checksum": true, "nonZero": true}},
+//	    {"source": "bitcoin:*", "destination": "sui:35834a8a"},
+//	    {"source": "*", "destination": "evm:8453", "paused": true, "reason": "maintenance"}
+//	  ]
+//	}
+//
+// where chains are matched by:
+//   - a chain id, in hex or notation as accepted by chainid.ParseLChainId
+//   - `<ecosystem>:*`, matching any chain of the ecosystem
+//   - `*`, matching any chain
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/address"
+	"github.com/lombard-finance/ledger-utils/chainid"
+)
+
+// Wildcard matches any chain, or any chain of an ecosystem when following it as `<ecosystem>:*`
+const Wildcard = "*"
+
+// ErrInvalidPolicy is returned when a policy cannot be loaded
+var ErrInvalidPolicy = fmt.Errorf("invalid route policy")
+
+// ErrRouteDenied is returned when a transfer is not allowed by the policy
+var ErrRouteDenied = fmt.Errorf("route denied")
+
+// ErrRouteNotAllowed is returned when no route of the policy matches the source and destination chains
+var ErrRouteNotAllowed = fmt.Errorf("%w: no matching route", ErrRouteDenied)
+
+// ErrRoutePaused is returned when a paused route matches the source and destination chains
+var ErrRoutePaused = fmt.Errorf("%w: route paused", ErrRouteDenied)
+
+// ErrRecipientNotAllowed is returned when the recipient does not satisfy the constraints of the route
+var ErrRecipientNotAllowed = fmt.Errorf("%w: recipient not allowed", ErrRouteDenied)
+
+// pattern matches chain ids by id, by ecosystem or any
+type pattern struct {
+	// id is the matched chain id, nil for wildcards
+	id chainid.LChainId
+	// ecosystem is the ecosystem of the matched chain ids, unless any
+	ecosystem chainid.Ecosystem
+	any       bool
+}
+
+func parsePattern(s string) (pattern, error) {
+	if s == Wildcard {
+		return pattern{any: true}, nil
+	}
+	if name, rest, found := strings.Cut(s, chainid.NotationSeparator); found && rest == Wildcard {
+		e, ok := chainid.LookupEcosystemByName(name)
+		if !ok {
+			return pattern{}, fmt.Errorf("%w: unknown ecosystem %q", ErrInvalidPolicy, name)
+		}
+		return pattern{ecosystem: e}, nil
+	}
+	id, err := chainid.ParseLChainId(s)
+	if err != nil {
+		return pattern{}, fmt.Errorf("%w: %w", ErrInvalidPolicy, err)
+	}
+	return pattern{id: id, ecosystem: id.Ecosystem()}, nil
+}
+
+func (p pattern) match(id chainid.LChainId) bool {
+	switch {
+	case p.any:
+		return true
+	case p.id == nil:
+		return id.Ecosystem() == p.ecosystem
+	default:
+		return p.id.Equal(id)
+	}
+}
+
+// specificity ranks patterns by the amount of chains they match, the higher the fewer
+func (p pattern) specificity() int {
+	switch {
+	case p.any:
+		return 0
+	case p.id == nil:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (p pattern) String() string {
+	switch {
+	case p.any:
+		return Wildcard
+	case p.id == nil:
+		return p.ecosystem.String() + chainid.NotationSeparator + Wildcard
+	default:
+		return chainid.FormatLChainId(p.id)
+	}
+}
+
+// recipientConstraint are the constraints on the recipient of a route
+type recipientConstraint struct {
+	// checksum requires EVM recipients given as their EIP-55 checksummed hex, so that only AllowString can allow them
+	checksum bool
+	// nonZero rejects the zero address of the destination ecosystem
+	nonZero bool
+	// allowlist are the only allowed recipients, if not nil
+	allowlist *address.AddressSet
+}
+
+// route is a route of the policy, from the chains matching source to the chains matching destination
+type route struct {
+	source      pattern
+	destination pattern
+	paused      bool
+	reason      string
+	recipient   recipientConstraint
+}
+
+func (r route) String() string {
+	return r.source.String() + " -> " + r.destination.String()
+}
+
+// Policy is a route policy loaded from JSON, see the package documentation for the format. The zero value denies
+// any route.
+type Policy struct {
+	routes      []route
+	environment *chainid.RoutePolicy
+}
+
+type policyJSON struct {
+	Environment *environmentJSON `json:"environment,omitempty"`
+	Routes      []routeJSON      `json:"routes"`
+}
+
+type environmentJSON struct {
+	AllowUnknown    bool `json:"allowUnknown"`
+	SameEnvironment bool `json:"sameEnvironment"`
+}
+
+type routeJSON struct {
+	Source      string         `json:"source"`
+	Destination string         `json:"destination"`
+	Paused      bool           `json:"paused,omitempty"`
+	Reason      string         `json:"reason,omitempty"`
+	Recipient   *recipientJSON `json:"recipient,omitempty"`
+}
+
+type recipientJSON struct {
+	Checksum  bool     `json:"checksum,omitempty"`
+	NonZero   bool     `json:"nonZero,omitempty"`
+	Allowlist []string `json:"allowlist,omitempty"`
+}
+
+// Parse creates a new Policy from its JSON encoding
+func Parse(data []byte) (*Policy, error) {
+	p := &Policy{}
+	if err := p.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Load creates a new Policy from the JSON encoding read from r
+func Load(r io.Reader) (*Policy, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// LoadFile creates a new Policy from the JSON file at path
+func LoadFile(path string) (*Policy, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// UnmarshalJSON decodes the policy, replacing the current one. Unknown fields are rejected, so that misspelled
+// constraints are not silently ignored, as well as routes with the same source and destination.
+func (p *Policy) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var in policyJSON
+	if err := decoder.Decode(&in); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidPolicy, err)
+	}
+
+	decoded := Policy{}
+	if in.Environment != nil {
+		decoded.environment = &chainid.RoutePolicy{
+			AllowUnknown:    in.Environment.AllowUnknown,
+			SameEnvironment: in.Environment.SameEnvironment,
+		}
+	}
+	seen := make(map[string]bool)
+	for i, encoded := range in.Routes {
+		r, err := decodeRoute(encoded)
+		if err != nil {
+			return fmt.Errorf("route %d: %w", i, err)
+		}
+		if seen[r.String()] {
+			return fmt.Errorf("%w: route %d: duplicate route %s", ErrInvalidPolicy, i, r)
+		}
+		seen[r.String()] = true
+		decoded.routes = append(decoded.routes, r)
+	}
+	*p = decoded
+	return nil
+}
+
+func decodeRoute(in routeJSON) (route, error) {
+	source, err := parsePattern(in.Source)
+	if err != nil {
+		return route{}, fmt.Errorf("source: %w", err)
+	}
+	destination, err := parsePattern(in.Destination)
+	if err != nil {
+		return route{}, fmt.Errorf("destination: %w", err)
+	}
+	r := route{source: source, destination: destination, paused: in.Paused, reason: in.Reason}
+	if in.Recipient == nil {
+		return r, nil
+	}
+	if in.Recipient.Checksum && (destination.any || destination.ecosystem != chainid.EcosystemEVM) {
+		return route{}, fmt.Errorf("%w: checksum requires evm destination, given %s", ErrInvalidPolicy, destination)
+	}
+	if in.Recipient.NonZero && destination.any {
+		return route{}, fmt.Errorf("%w: nonZero requires the destination ecosystem", ErrInvalidPolicy)
+	}
+	r.recipient = recipientConstraint{checksum: in.Recipient.Checksum, nonZero: in.Recipient.NonZero}
+	if in.Recipient.Allowlist == nil {
+		return r, nil
+	}
+	if destination.any {
+		return route{}, fmt.Errorf("%w: allowlist requires the destination ecosystem", ErrInvalidPolicy)
+	}
+	r.recipient.allowlist = address.NewAddressSet()
+	for _, s := range in.Recipient.Allowlist {
+		var a address.Address
+		if destination.id != nil {
+			a, err = address.ParseAddressForChain(destination.id, s)
+		} else {
+			a, err = address.NewAddressFromString(s, destination.ecosystem)
+		}
+		if err != nil {
+			return route{}, fmt.Errorf("%w: allowlist: %w", ErrInvalidPolicy, err)
+		}
+		r.recipient.allowlist.Add(a)
+	}
+	return r, nil
+}
+
+// Allow returns nil if the transfer from src to the recipient on dst is allowed by the policy, an ErrRouteDenied
+// explaining why otherwise. Checks are performed in order:
+//   - the environments of the chains, if the policy has environment guardrails, see chainid.RoutePolicy
+//   - paused routes, denying the transfer if any matching route is paused, regardless of specificity
+//   - the most specific matching route, where chain ids are more specific than ecosystems and ecosystems than
+//     wildcards, comparing sources first
+//   - the recipient, which must be valid on dst according to address.ValidateAddressForChain and satisfy the
+//     constraints of the route
+//
+// The EIP-55 checksum cannot be verified, since an address.Address does not carry the string it was parsed from,
+// so that routes requiring it deny any recipient: use AllowString when the route may require it.
+func (p *Policy) Allow(src, dst chainid.LChainId, recipient address.Address) error {
+	r, err := p.route(src, dst)
+	if err != nil {
+		return err
+	}
+	return r.allowRecipient(src, dst, recipient, false)
+}
+
+// AllowString is like Allow, parsing the recipient with address.ParseAddressForChain. When the matching route
+// requires the EIP-55 checksum, the recipient is parsed with address.NewEvmAddressFromChecksumHex instead, so that
+// recipients whose case does not match the checksum are denied.
+func (p *Policy) AllowString(src, dst chainid.LChainId, recipient string) error {
+	r, err := p.route(src, dst)
+	if err != nil {
+		return err
+	}
+	var a address.Address
+	if r.recipient.checksum {
+		a, err = address.NewEvmAddressFromChecksumHex(recipient)
+	} else {
+		a, err = address.ParseAddressForChain(dst, recipient)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s allowed by %s: %w", ErrRecipientNotAllowed, describe(src, dst), r, err)
+	}
+	return r.allowRecipient(src, dst, a, r.recipient.checksum)
+}
+
+// route returns the most specific route allowing the transfer from src to dst, see Allow
+func (p *Policy) route(src, dst chainid.LChainId) (*route, error) {
+	if src == nil || dst == nil {
+		return nil, fmt.Errorf("%w: missing chain id", ErrRouteDenied)
+	}
+	if p.environment != nil {
+		if err := p.environment.Validate(src, dst); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRouteDenied, err)
+		}
+	}
+
+	var best *route
+	for i := range p.routes {
+		r := &p.routes[i]
+		if !r.source.match(src) || !r.destination.match(dst) {
+			continue
+		}
+		if r.paused {
+			if r.reason == "" {
+				return nil, fmt.Errorf("%w: %s paused by %s", ErrRoutePaused, describe(src, dst), r)
+			}
+			return nil, fmt.Errorf("%w: %s paused by %s: %s", ErrRoutePaused, describe(src, dst), r, r.reason)
+		}
+		if best == nil || moreSpecific(r, best) {
+			best = r
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("%w: %s", ErrRouteNotAllowed, describe(src, dst))
+	}
+	return best, nil
+}
+
+func (r *route) allowRecipient(src, dst chainid.LChainId, recipient address.Address, checksummed bool) error {
+	if err := r.checkRecipient(dst, recipient, checksummed); err != nil {
+		return fmt.Errorf("%w: %s allowed by %s: %w", ErrRecipientNotAllowed, describe(src, dst), r, err)
+	}
+	return nil
+}
+
+// checkRecipient checks the recipient against the constraints of the route, where checksummed reports whether the
+// recipient was parsed from its EIP-55 checksummed hex
+func (r *route) checkRecipient(dst chainid.LChainId, recipient address.Address, checksummed bool) error {
+	if err := address.ValidateAddressForChain(dst, recipient); err != nil {
+		return err
+	}
+	if r.recipient.checksum {
+		if _, ok := recipient.(*address.EvmAddress); !ok {
+			return fmt.Errorf("%s is %T, expected *address.EvmAddress", recipient, recipient)
+		}
+		if !checksummed {
+			return fmt.Errorf("%w: %s can only be verified by AllowString", address.ErrBadChecksumEvm, recipient)
+		}
+	}
+	if r.recipient.nonZero && recipient.Equal(address.NewZeroAddress(dst.Ecosystem())) {
+		return fmt.Errorf("zero address %s", recipient)
+	}
+	if r.recipient.allowlist != nil && !r.recipient.allowlist.Contains(recipient) {
+		return fmt.Errorf("%s is not in the allowlist", recipient)
+	}
+	return nil
+}
+
+// moreSpecific reports whether r1 is more specific than r2, comparing sources first and then destinations
+func moreSpecific(r1, r2 *route) bool {
+	if r1.source.specificity() != r2.source.specificity() {
+		return r1.source.specificity() > r2.source.specificity()
+	}
+	return r1.destination.specificity() > r2.destination.specificity()
+}
+
+func describe(src, dst chainid.LChainId) string {
+	return "from " + chainid.FormatLChainId(src) + " to " + chainid.FormatLChainId(dst)
+}
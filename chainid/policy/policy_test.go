@@ -0,0 +1,160 @@
+package policy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/address"
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/chainid/policy"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+const testPolicy = `{
+  "environment": {"allowUnknown": true},
+  "routes": [
+    {"source": "bitcoin:000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", "destination": "evm:*",
+      "recipient": {"checksum": true, "nonZero": true}},
+    {"source": "bitcoin:*", "destination": "sui:*"},
+    {"source": "evm:1", "destination": "evm:8453", "recipient": {"allowlist": ["0x8236a87084f8B84306f72007F36F2618A5634494"]}},
+    {"source": "*", "destination": "evm:8453"},
+    {"source": "*", "destination": "solana:*", "paused": true, "reason": "maintenance"},
+    {"source": "evm:11155111", "destination": "*"}
+  ]
+}`
+
+const checksummedRecipient = "0x8236a87084f8B84306f72007F36F2618A5634494"
+
+func TestPolicyAllow(t *testing.T) {
+	p, err := policy.Parse([]byte(testPolicy))
+	common.AssertNoError(t, err)
+
+	evmRecipient, err := address.NewEvmAddressFromHex(checksummedRecipient)
+	common.AssertNoError(t, err)
+	suiRecipient, err := address.NewSuiAddressFromHex("0x" + strings.Repeat("ab", 32))
+	common.AssertNoError(t, err)
+
+	t.Run("should allow matching routes", func(t *testing.T) {
+		common.AssertNoError(t, p.Allow(chainid.NewBitcoinLChainId(), chainid.NewSuiMainnetLChainId(), suiRecipient))
+		common.AssertNoError(t, p.Allow(chainid.NewEVMBinanceSmartChainLChainId(), chainid.NewEVMBaseLChainId(), evmRecipient))
+		common.AssertNoError(t, p.Allow(chainid.NewEVMSepoliaLChainId(), chainid.NewSuiTestnetLChainId(), suiRecipient))
+		common.AssertNoError(t, p.AllowString(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), checksummedRecipient))
+	})
+
+	t.Run("should deny routes without match", func(t *testing.T) {
+		err := p.Allow(chainid.NewEVMEthereumLChainId(), chainid.NewSuiMainnetLChainId(), suiRecipient)
+		common.AssertError(t, err, policy.ErrRouteDenied, policy.ErrRouteNotAllowed)
+		err = p.Allow(chainid.NewSuiMainnetLChainId(), chainid.NewBitcoinLChainId(), evmRecipient)
+		common.AssertError(t, err, policy.ErrRouteDenied, policy.ErrRouteNotAllowed)
+	})
+
+	t.Run("should deny paused routes regardless of specificity", func(t *testing.T) {
+		solanaRecipient := address.NewZeroAddress(chainid.EcosystemSolana)
+		err := p.Allow(chainid.NewEVMSepoliaLChainId(), chainid.NewSolanaDevnetLChainId(), solanaRecipient)
+		common.AssertError(t, err, policy.ErrRouteDenied, policy.ErrRoutePaused)
+		common.AssertTrue(t, strings.Contains(err.Error(), "maintenance"))
+	})
+
+	t.Run("should deny routes across environments", func(t *testing.T) {
+		err := p.Allow(chainid.NewEVMSepoliaLChainId(), chainid.NewEVMBaseLChainId(), evmRecipient)
+		common.AssertError(t, err, policy.ErrRouteDenied, chainid.ErrEnvironmentMismatch)
+	})
+
+	t.Run("should apply the constraints of the most specific route", func(t *testing.T) {
+		other, err := address.NewEvmAddressFromHex("0xA1Bc65eCf8BC7B2FAA22c53bcC49b0376Da3845A")
+		common.AssertNoError(t, err)
+		// allowlist of evm:1 -> evm:8453 rather than * -> evm:8453
+		common.AssertNoError(t, p.Allow(chainid.NewEVMEthereumLChainId(), chainid.NewEVMBaseLChainId(), evmRecipient))
+		err = p.Allow(chainid.NewEVMEthereumLChainId(), chainid.NewEVMBaseLChainId(), other)
+		common.AssertError(t, err, policy.ErrRouteDenied, policy.ErrRecipientNotAllowed)
+		common.AssertNoError(t, p.Allow(chainid.NewEVMSonicLChainId(), chainid.NewEVMBaseLChainId(), other))
+	})
+
+	t.Run("should check the recipient constraints", func(t *testing.T) {
+		// checksum can only be verified when the recipient is given as string, so that Allow denies it
+		lower := strings.ToLower(checksummedRecipient)
+		err = p.AllowString(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), lower)
+		common.AssertError(t, err, policy.ErrRouteDenied, policy.ErrRecipientNotAllowed, address.ErrBadChecksumEvm)
+		lowerAddress, err := address.NewEvmAddressFromHex(lower)
+		common.AssertNoError(t, err)
+		err = p.Allow(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), lowerAddress)
+		common.AssertError(t, err, policy.ErrRecipientNotAllowed, address.ErrBadChecksumEvm)
+		err = p.Allow(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), evmRecipient)
+		common.AssertError(t, err, policy.ErrRecipientNotAllowed, address.ErrBadChecksumEvm)
+		generic, err := address.NewGenericAddressFromHex(checksummedRecipient, chainid.EcosystemEVM)
+		common.AssertNoError(t, err)
+		err = p.Allow(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), generic)
+		common.AssertError(t, err, policy.ErrRecipientNotAllowed)
+		common.AssertTrue(t, strings.Contains(err.Error(), "expected *address.EvmAddress"))
+		// routes without the constraint accept any case
+		common.AssertNoError(t, p.AllowString(chainid.NewEVMEthereumLChainId(), chainid.NewEVMBaseLChainId(), lower))
+
+		err = p.AllowString(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), "0x"+strings.Repeat("0", 40))
+		common.AssertError(t, err, policy.ErrRecipientNotAllowed)
+		common.AssertTrue(t, strings.Contains(err.Error(), "zero address"))
+
+		err = p.Allow(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), suiRecipient)
+		common.AssertError(t, err, policy.ErrRecipientNotAllowed, address.ErrEcosystemMismatch)
+		err = p.Allow(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), nil)
+		common.AssertError(t, err, policy.ErrRecipientNotAllowed, address.ErrEmptyAddress)
+		err = p.AllowString(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), "not an address")
+		common.AssertError(t, err, policy.ErrRecipientNotAllowed, address.ErrBadAddress)
+		err = p.AllowString(chainid.NewBitcoinLChainId(), chainid.NewSuiMainnetLChainId(), "not an address")
+		common.AssertError(t, err, policy.ErrRecipientNotAllowed, address.ErrBadAddress)
+		err = p.AllowString(chainid.NewEVMEthereumLChainId(), chainid.NewSuiMainnetLChainId(), checksummedRecipient)
+		common.AssertError(t, err, policy.ErrRouteDenied, policy.ErrRouteNotAllowed)
+	})
+
+	t.Run("should deny any route with the zero value", func(t *testing.T) {
+		err := (&policy.Policy{}).Allow(chainid.NewBitcoinLChainId(), chainid.NewEVMEthereumLChainId(), evmRecipient)
+		common.AssertError(t, err, policy.ErrRouteNotAllowed)
+	})
+}
+
+func TestPolicyEnvironment(t *testing.T) {
+	unknown, err := chainid.ParseLChainId("evm:424242")
+	common.AssertNoError(t, err)
+	recipient, err := address.NewEvmAddressFromHex(checksummedRecipient)
+	common.AssertNoError(t, err)
+
+	strict, err := policy.Parse([]byte(`{"environment": {}, "routes": [{"source": "*", "destination": "*"}]}`))
+	common.AssertNoError(t, err)
+	err = strict.Allow(unknown, chainid.NewEVMEthereumLChainId(), recipient)
+	common.AssertError(t, err, policy.ErrRouteDenied, chainid.ErrUnknownEnvironment)
+
+	// without guardrails, environments are not checked
+	open, err := policy.Parse([]byte(`{"routes": [{"source": "*", "destination": "*"}]}`))
+	common.AssertNoError(t, err)
+	common.AssertNoError(t, open.Allow(unknown, chainid.NewEVMEthereumLChainId(), recipient))
+	common.AssertNoError(t, open.Allow(chainid.NewEVMSepoliaLChainId(), chainid.NewEVMEthereumLChainId(), recipient))
+}
+
+func TestPolicyLoad(t *testing.T) {
+	_, err := policy.Load(strings.NewReader(testPolicy))
+	common.AssertNoError(t, err)
+
+	t.Run("should reject invalid policies", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			policy string
+		}{
+			{"malformed", `{"routes": [`},
+			{"unknown field", `{"routes": [{"source": "*", "destination": "*", "pasued": true}]}`},
+			{"unknown ecosystem", `{"routes": [{"source": "foo:*", "destination": "*"}]}`},
+			{"bad chain id", `{"routes": [{"source": "evm:x", "destination": "*"}]}`},
+			{"missing chain", `{"routes": [{"source": "*"}]}`},
+			{"duplicate", `{"routes": [{"source": "evm:1", "destination": "*"}, {"source": "0x` +
+				"00" + strings.Repeat("0", 60) + "01" + `", "destination": "*"}]}`},
+			{"checksum on non evm", `{"routes": [{"source": "*", "destination": "sui:*", "recipient": {"checksum": true}}]}`},
+			{"checksum on any", `{"routes": [{"source": "*", "destination": "*", "recipient": {"checksum": true}}]}`},
+			{"allowlist on any", `{"routes": [{"source": "*", "destination": "*", "recipient": {"allowlist": []}}]}`},
+			{"bad allowlist", `{"routes": [{"source": "*", "destination": "evm:1", "recipient": {"allowlist": ["0x12"]}}]}`},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := policy.Parse([]byte(tt.policy))
+				common.AssertError(t, err, policy.ErrInvalidPolicy)
+			})
+		}
+	})
+}
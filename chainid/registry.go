@@ -0,0 +1,133 @@
+package chainid
+
+import (
+	"fmt"
+	"sync"
+)
+
+// EcosystemSpec describes an Ecosystem to the package, so that ecosystems not built into the library can be
+// plugged in by downstream modules through RegisterEcosystem.
+type EcosystemSpec struct {
+	// Name is the human readable name of the ecosystem, returned by Ecosystem.String. It must be unique.
+	Name string
+	// Wrap converts a validated chain id of the ecosystem into its specialized LChainId, returned by NewLChainId.
+	// Downstream types can embed GenericLChainId to inherit the common methods. When nil, the GenericLChainId is
+	// returned as-is.
+	Wrap func(id GenericLChainId) LChainId
+}
+
+var ErrEcosystemAlreadyRegistered = fmt.Errorf("ecosystem already registered")
+var ErrInvalidEcosystemSpec = fmt.Errorf("invalid ecosystem spec")
+
+// ecosystems is the registry of the supported ecosystems, pre-populated with the built-in ones
+var ecosystems = struct {
+	sync.RWMutex
+	specs map[Ecosystem]EcosystemSpec
+	names map[string]Ecosystem
+}{
+	specs: map[Ecosystem]EcosystemSpec{
+		EcosystemEVM: {
+			Name: "evm",
+			Wrap: func(id GenericLChainId) LChainId { return EVMLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemSui: {
+			Name: "sui",
+			Wrap: func(id GenericLChainId) LChainId { return SuiLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemSolana: {
+			Name: "solana",
+			Wrap: func(id GenericLChainId) LChainId { return SolanaLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemCosmos: {
+			Name: "cosmos",
+			Wrap: func(id GenericLChainId) LChainId { return CosmosLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemStarknet: {
+			Name: "starknet",
+			Wrap: func(id GenericLChainId) LChainId { return StarknetLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemAptos: {
+			Name: "aptos",
+			Wrap: func(id GenericLChainId) LChainId { return AptosLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemTon: {
+			Name: "ton",
+			Wrap: func(id GenericLChainId) LChainId { return TonLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemTron: {
+			Name: "tron",
+			Wrap: func(id GenericLChainId) LChainId { return TronLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemStellar: {
+			Name: "stellar",
+			Wrap: func(id GenericLChainId) LChainId { return StellarLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemXrpl: {
+			Name: "xrpl",
+			Wrap: func(id GenericLChainId) LChainId { return XrplLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemSubstrate: {
+			Name: "substrate",
+			Wrap: func(id GenericLChainId) LChainId { return SubstrateLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemNear: {
+			Name: "near",
+			Wrap: func(id GenericLChainId) LChainId { return NearLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemCardano: {
+			Name: "cardano",
+			Wrap: func(id GenericLChainId) LChainId { return CardanoLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemAlgorand: {
+			Name: "algorand",
+			Wrap: func(id GenericLChainId) LChainId { return AlgorandLChainId{lChainId: id.lChainId} },
+		},
+		EcosystemBitcoin: {
+			Name: "bitcoin",
+			Wrap: func(id GenericLChainId) LChainId { return BitcoinLChainId{lChainId: id.lChainId} },
+		},
+	},
+}
+
+func init() {
+	ecosystems.names = make(map[string]Ecosystem, len(ecosystems.specs))
+	for e, spec := range ecosystems.specs {
+		ecosystems.names[spec.Name] = e
+	}
+}
+
+// RegisterEcosystem makes the ecosystem identified by the given MSB supported by the package, according to
+// spec. It fails if the ecosystem or its name is already registered, either as built-in or by a previous call.
+// It is safe for concurrent use.
+func RegisterEcosystem(e Ecosystem, spec EcosystemSpec) error {
+	if spec.Name == "" {
+		return fmt.Errorf("%w: empty name for ecosystem %d", ErrInvalidEcosystemSpec, e)
+	}
+	ecosystems.Lock()
+	defer ecosystems.Unlock()
+	if existing, ok := ecosystems.specs[e]; ok {
+		return fmt.Errorf("%w: %d is %s", ErrEcosystemAlreadyRegistered, e, existing.Name)
+	}
+	if existing, ok := ecosystems.names[spec.Name]; ok {
+		return fmt.Errorf("%w: name %s is used by %d", ErrEcosystemAlreadyRegistered, spec.Name, existing)
+	}
+	ecosystems.specs[e] = spec
+	ecosystems.names[spec.Name] = e
+	return nil
+}
+
+// LookupEcosystem returns the spec of the ecosystem and whether the ecosystem is registered
+func LookupEcosystem(e Ecosystem) (EcosystemSpec, bool) {
+	ecosystems.RLock()
+	defer ecosystems.RUnlock()
+	spec, ok := ecosystems.specs[e]
+	return spec, ok
+}
+
+// LookupEcosystemByName returns the ecosystem registered with the given name and whether it exists
+func LookupEcosystemByName(name string) (Ecosystem, bool) {
+	ecosystems.RLock()
+	defer ecosystems.RUnlock()
+	e, ok := ecosystems.names[name]
+	return e, ok
+}
@@ -0,0 +1,115 @@
+package chainid_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+// downstreamLChainId simulates a chain id type defined outside of the package
+type downstreamLChainId struct {
+	chainid.GenericLChainId
+}
+
+func TestRegisterEcosystem(t *testing.T) {
+	ecosystem := chainid.Ecosystem(100)
+	hexChainId := "0x64000000000000000000000000000000000000000000000000000000000000aa"
+
+	common.AssertFalse(t, ecosystem.IsSupported())
+	common.EqualStrings(t, "ecosystem 100", ecosystem.String())
+	chainId, err := chainid.NewLChainIdFromHex(hexChainId)
+	common.AssertNoError(t, err)
+	_, ok := chainId.(chainid.GenericLChainId)
+	common.AssertTrue(t, ok)
+
+	err = chainid.RegisterEcosystem(ecosystem, chainid.EcosystemSpec{
+		Name: "downstream",
+		Wrap: func(id chainid.GenericLChainId) chainid.LChainId { return downstreamLChainId{id} },
+	})
+	common.AssertNoError(t, err)
+
+	common.AssertTrue(t, ecosystem.IsSupported())
+	common.EqualStrings(t, "downstream", ecosystem.String())
+	chainId, err = chainid.NewLChainIdFromHex(hexChainId)
+	common.AssertNoError(t, err)
+	downstream, ok := chainId.(downstreamLChainId)
+	common.AssertTrue(t, ok)
+	common.EqualStrings(t, hexChainId, downstream.String())
+	spec, ok := chainid.LookupEcosystem(ecosystem)
+	common.AssertTrue(t, ok)
+	common.EqualStrings(t, "downstream", spec.Name)
+	byName, ok := chainid.LookupEcosystemByName("downstream")
+	common.AssertTrue(t, ok)
+	common.AssertTrue(t, byName == ecosystem)
+
+	// duplicate ecosystem
+	err = chainid.RegisterEcosystem(ecosystem, chainid.EcosystemSpec{Name: "another"})
+	common.AssertError(t, err, chainid.ErrEcosystemAlreadyRegistered)
+	// duplicate name
+	err = chainid.RegisterEcosystem(chainid.Ecosystem(101), chainid.EcosystemSpec{Name: "downstream"})
+	common.AssertError(t, err, chainid.ErrEcosystemAlreadyRegistered)
+	// built-in ecosystem
+	err = chainid.RegisterEcosystem(chainid.EcosystemEVM, chainid.EcosystemSpec{Name: "another"})
+	common.AssertError(t, err, chainid.ErrEcosystemAlreadyRegistered)
+	err = chainid.RegisterEcosystem(chainid.Ecosystem(101), chainid.EcosystemSpec{Name: "evm"})
+	common.AssertError(t, err, chainid.ErrEcosystemAlreadyRegistered)
+	// invalid spec
+	err = chainid.RegisterEcosystem(chainid.Ecosystem(101), chainid.EcosystemSpec{})
+	common.AssertError(t, err, chainid.ErrInvalidEcosystemSpec)
+	common.AssertFalse(t, chainid.Ecosystem(101).IsSupported())
+}
+
+func TestRegisterEcosystem_WithoutWrap(t *testing.T) {
+	ecosystem := chainid.Ecosystem(102)
+	err := chainid.RegisterEcosystem(ecosystem, chainid.EcosystemSpec{Name: "downstream without wrap"})
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, ecosystem.IsSupported())
+
+	chainId, err := chainid.NewLChainIdFromHex("0x66000000000000000000000000000000000000000000000000000000000000aa")
+	common.AssertNoError(t, err)
+	_, ok := chainId.(chainid.GenericLChainId)
+	common.AssertTrue(t, ok)
+}
+
+func TestRegisterEcosystem_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// half of the goroutines race on the same ecosystem
+			e := chainid.Ecosystem(110 + i%10)
+			errs <- chainid.RegisterEcosystem(e, chainid.EcosystemSpec{Name: fmt.Sprintf("concurrent %d", i)})
+			_ = e.String()
+			_ = e.IsSupported()
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		common.AssertError(t, err, chainid.ErrEcosystemAlreadyRegistered)
+	}
+	common.AssertTrue(t, succeeded == 10)
+}
+
+func TestBuiltinEcosystems(t *testing.T) {
+	for _, name := range []string{
+		"evm", "sui", "solana", "cosmos", "starknet", "aptos", "ton", "tron", "stellar", "xrpl", "substrate", "near",
+		"cardano", "algorand", "bitcoin",
+	} {
+		e, ok := chainid.LookupEcosystemByName(name)
+		common.AssertTrue(t, ok)
+		common.AssertTrue(t, e.IsSupported())
+		common.EqualStrings(t, name, e.String())
+	}
+}
@@ -9,16 +9,17 @@ type SolanaLChainId struct {
 }
 
 func NewSolanaLChainId(genesisHash string) (SolanaLChainId, error) {
-	decoded, err := base58.Decode(genesisHash)
+	var decoded [SolanaGenesisHashLength]byte
+	n, err := base58.DecodeInto(decoded[:], genesisHash)
 	if err != nil {
-		return SolanaLChainId{}, err
+		return SolanaLChainId{}, NewErrLChainIdInvalid(err)
 	}
-	if len(decoded) != SolanaGenesisHashLength {
-		return SolanaLChainId{}, NewErrLength(SolanaGenesisHashLength, len(decoded))
+	if n != SolanaGenesisHashLength {
+		return SolanaLChainId{}, NewErrLength(SolanaGenesisHashLength, n)
 	}
 	// swap MSB with our ecosystem id
 	decoded[0] = byte(EcosystemSolana)
-	innerChainId, err := newLChainId(decoded)
+	innerChainId, err := newLChainId(decoded[:])
 	if err != nil {
 		return SolanaLChainId{}, err
 	}
@@ -1,10 +1,12 @@
 package chainid_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/lombard-finance/ledger-utils/chainid"
 	"github.com/lombard-finance/ledger-utils/common"
+	"github.com/lombard-finance/ledger-utils/common/base58"
 )
 
 func TestSolanaLChainId_NewLChainIdFromHex(t *testing.T) {
@@ -42,3 +44,15 @@ func TestSolanaLChainId_AsMapKey(t *testing.T) {
 	common.EqualStrings(t, "ok", m[b])
 	common.EqualStrings(t, "ok", m[c])
 }
+
+func TestSolanaLChainId_InvalidGenesisHash(t *testing.T) {
+	// shorter hash
+	_, err := chainid.NewSolanaLChainId("5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid, chainid.ErrLength)
+	// oversized input is rejected before decoding
+	_, err = chainid.NewSolanaLChainId(strings.Repeat("5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d", 1000))
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid, base58.ErrInvalidLength)
+	// invalid character
+	_, err = chainid.NewSolanaLChainId("0eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid, base58.ErrInvalidCharacter)
+}
@@ -0,0 +1,53 @@
+package chainid
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+var ErrEmptyStellarPassphrase = fmt.Errorf("cannot create Lombard chain Id from empty stellar network passphrase")
+
+// StellarPubnetPassphrase is the network passphrase of the Stellar public network
+const StellarPubnetPassphrase = "Public Global Stellar Network ; September 2015"
+
+// StellarTestnetPassphrase is the network passphrase of the Stellar test network
+const StellarTestnetPassphrase = "Test SDF Network ; September 2015"
+
+type StellarLChainId struct {
+	lChainId
+}
+
+// NewStellarLChainId generates a new Lombard Chain Id for a Stellar network given its network passphrase.
+// The resulting Lombard Chain Id is the network id, i.e. the sha256 hash of the passphrase, with its MSB
+// replaced by the ecosystem byte.
+func NewStellarLChainId(passphrase string) (StellarLChainId, error) {
+	if passphrase == "" {
+		return StellarLChainId{}, NewErrLChainIdInvalid(ErrEmptyStellarPassphrase)
+	}
+	networkId := sha256.Sum256([]byte(passphrase))
+	// Replace MSB with stellar ecosystem byte
+	networkId[0] = byte(EcosystemStellar)
+	innerChainId, err := newLChainId(networkId[:])
+	if err != nil {
+		return StellarLChainId{}, err
+	}
+	return StellarLChainId{lChainId: *innerChainId}, nil
+}
+
+// NewStellarPubnetLChainId returns the LChainId for the Stellar public network
+func NewStellarPubnetLChainId() StellarLChainId {
+	return StellarLChainId{
+		lChainId{
+			inner: [32]byte{0x08, 0xc3, 0x39, 0x97, 0x54, 0x4e, 0x31, 0x75, 0xd2, 0x66, 0xbd, 0x02, 0x24, 0x39, 0xb2, 0x2c, 0xdb, 0x16, 0x50, 0x8c, 0x01, 0x16, 0x3f, 0x26, 0xe5, 0xcb, 0x2a, 0x3e, 0x10, 0x45, 0xa9, 0x79},
+		},
+	}
+}
+
+// NewStellarTestnetLChainId returns the LChainId for the Stellar test network
+func NewStellarTestnetLChainId() StellarLChainId {
+	return StellarLChainId{
+		lChainId{
+			inner: [32]byte{0x08, 0xe0, 0x30, 0x2d, 0x59, 0x84, 0x4d, 0x32, 0xbd, 0xca, 0x91, 0x5c, 0x82, 0x03, 0xdd, 0x44, 0xb3, 0x3f, 0xbb, 0x7e, 0xdc, 0x19, 0x05, 0x1e, 0xa3, 0x7a, 0xbe, 0xdf, 0x28, 0xec, 0xd4, 0x72},
+		},
+	}
+}
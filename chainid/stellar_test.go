@@ -0,0 +1,23 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestStellarLChainId_NewStellarLChainId(t *testing.T) {
+	ch, err := chainid.NewStellarLChainId(chainid.StellarPubnetPassphrase)
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, ch.Equal(chainid.NewStellarPubnetLChainId()))
+	common.EqualStrings(t, "stellar", ch.Ecosystem().String())
+
+	_, err = chainid.NewStellarLChainId("")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid, chainid.ErrEmptyStellarPassphrase)
+
+	fromHex, err := chainid.NewLChainIdFromHex(ch.String())
+	common.AssertNoError(t, err)
+	_, ok := fromHex.(chainid.StellarLChainId)
+	common.AssertTrue(t, ok)
+}
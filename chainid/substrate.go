@@ -0,0 +1,73 @@
+package chainid
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+const SubstrateGenesisHashLength = 32
+
+type SubstrateLChainId struct {
+	lChainId
+}
+
+// NewSubstrateLChainId generates a new Lombard Chain Id for a Substrate chain given the hex encoding of its
+// genesis hash, with or without leading 0x. The resulting Lombard Chain Id is the genesis hash with its MSB
+// replaced by the ecosystem byte.
+func NewSubstrateLChainId(genesisHash string) (SubstrateLChainId, error) {
+	var decoded [SubstrateGenesisHashLength]byte
+	trimmed := strings.TrimPrefix(genesisHash, "0x")
+	if len(trimmed) != SubstrateGenesisHashLength*2 {
+		return SubstrateLChainId{}, NewErrLength(SubstrateGenesisHashLength, len(trimmed)/2)
+	}
+	if _, err := hex.Decode(decoded[:], []byte(trimmed)); err != nil {
+		return SubstrateLChainId{}, NewErrLChainIdInvalid(err)
+	}
+	// swap MSB with our ecosystem id
+	decoded[0] = byte(EcosystemSubstrate)
+	innerChainId, err := newLChainId(decoded[:])
+	if err != nil {
+		return SubstrateLChainId{}, err
+	}
+	return SubstrateLChainId{lChainId: *innerChainId}, nil
+}
+
+// NewPolkadotLChainId returns the LChainId for the Polkadot relay chain
+func NewPolkadotLChainId() SubstrateLChainId {
+	return SubstrateLChainId{
+		lChainId{
+			inner: [32]byte{byte(EcosystemSubstrate), 0xb1, 0x71, 0xbb, 0x15, 0x8e, 0x2d, 0x38, 0x48, 0xfa, 0x23, 0xa9, 0xf1, 0xc2, 0x51, 0x82, 0xfb, 0x8e, 0x20, 0x31, 0x3b, 0x2c, 0x1e, 0xb4, 0x92, 0x19, 0xda, 0x7a, 0x70, 0xce, 0x90, 0xc3},
+		},
+	}
+}
+
+// NewKusamaLChainId returns the LChainId for the Kusama relay chain
+func NewKusamaLChainId() SubstrateLChainId {
+	return SubstrateLChainId{
+		lChainId{
+			inner: [32]byte{byte(EcosystemSubstrate), 0xa8, 0xd4, 0x93, 0x28, 0x5c, 0x2d, 0xf7, 0x32, 0x90, 0xdf, 0xb7, 0xe6, 0x1f, 0x87, 0x0f, 0x17, 0xb4, 0x18, 0x01, 0x19, 0x7a, 0x14, 0x9c, 0xa9, 0x36, 0x54, 0x49, 0x9e, 0xa3, 0xda, 0xfe},
+		},
+	}
+}
+
+// NewWestendLChainId returns the LChainId for the Westend test relay chain
+func NewWestendLChainId() SubstrateLChainId {
+	return SubstrateLChainId{
+		lChainId{
+			inner: [32]byte{byte(EcosystemSubstrate), 0x43, 0xf2, 0x38, 0x03, 0xac, 0x50, 0xe8, 0xf6, 0xf8, 0xe6, 0x26, 0x95, 0xd1, 0xce, 0x9e, 0x4e, 0x1d, 0x68, 0xaa, 0x36, 0xc1, 0xcd, 0x2c, 0xfd, 0x15, 0x34, 0x02, 0x13, 0xf3, 0x42, 0x3e},
+		},
+	}
+}
+
+// knownSS58Prefixes is the registry of the SS58 network prefixes of the Substrate chains known to the library
+var knownSS58Prefixes = map[SubstrateLChainId]uint16{
+	NewPolkadotLChainId(): 0,
+	NewKusamaLChainId():   2,
+	NewWestendLChainId():  42,
+}
+
+// SS58Prefix returns the SS58 network prefix of the chain and whether the chain is among the known ones
+func (c SubstrateLChainId) SS58Prefix() (uint16, bool) {
+	prefix, ok := knownSS58Prefixes[c]
+	return prefix, ok
+}
@@ -0,0 +1,67 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestSubstrateLChainId_NewSubstrateLChainId(t *testing.T) {
+	ch, err := chainid.NewSubstrateLChainId("0x91b171bb158e2d3848fa23a9f1c25182fb8e20313b2c1eb49219da7a70ce90c3")
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, ch.Equal(chainid.NewPolkadotLChainId()))
+	common.EqualStrings(t, "substrate", ch.Ecosystem().String())
+	common.AssertTrue(t, ch.Ecosystem().IsSupported())
+
+	// leading 0x is optional
+	ch, err = chainid.NewSubstrateLChainId("b0a8d493285c2df73290dfb7e61f870f17b41801197a149ca93654499ea3dafe")
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, ch.Equal(chainid.NewKusamaLChainId()))
+
+	fromHex, err := chainid.NewLChainIdFromHex(ch.String())
+	common.AssertNoError(t, err)
+	_, ok := fromHex.(chainid.SubstrateLChainId)
+	common.AssertTrue(t, ok)
+}
+
+func TestSubstrateLChainId_InvalidGenesisHash(t *testing.T) {
+	// shorter hash
+	_, err := chainid.NewSubstrateLChainId("0x91b171bb158e2d3848fa23a9f1c25182fb8e20313b2c1eb49219da7a70ce90")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid)
+	// invalid hex
+	_, err = chainid.NewSubstrateLChainId("0x91b171bb158e2d3848fa23a9f1c25182fb8e20313b2c1eb49219da7a70ce90zz")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid)
+}
+
+// These tests verify that LChainId concrete types are usable as map keys.
+func TestSubstrateLChainId_AsMapKey(t *testing.T) {
+	// Use two distinct equal instances
+	a := chainid.NewWestendLChainId()
+	b, err := chainid.NewSubstrateLChainId("0xe143f23803ac50e8f6f8e62695d1ce9e4e1d68aa36c1cd2cfd15340213f3423e")
+	common.AssertNoError(t, err)
+	c, err := chainid.NewLChainIdFromHex(a.String())
+	common.AssertNoError(t, err)
+
+	m := map[chainid.LChainId]string{}
+	m[a] = "ok"
+
+	// same key instance
+	common.EqualStrings(t, "ok", m[a])
+	// distinct but equal value should still map to the same bucket if comparable by value
+	common.EqualStrings(t, "ok", m[b])
+	common.EqualStrings(t, "ok", m[c])
+}
+
+func TestSubstrateLChainId_SS58Prefix(t *testing.T) {
+	prefix, ok := chainid.NewPolkadotLChainId().SS58Prefix()
+	common.AssertTrue(t, ok && prefix == 0)
+	prefix, ok = chainid.NewKusamaLChainId().SS58Prefix()
+	common.AssertTrue(t, ok && prefix == 2)
+	prefix, ok = chainid.NewWestendLChainId().SS58Prefix()
+	common.AssertTrue(t, ok && prefix == 42)
+	unknown, err := chainid.NewSubstrateLChainId("0x00000000000000000000000000000000000000000000000000000000000000aa")
+	common.AssertNoError(t, err)
+	_, ok = unknown.SS58Prefix()
+	common.AssertFalse(t, ok)
+}
@@ -0,0 +1,34 @@
+package chainid
+
+import "encoding/binary"
+
+// TonLChainId is the LChainId of TON networks, whose least significant 4 bytes are the global id of the
+// network as a big endian signed 32 bits integer
+type TonLChainId struct {
+	lChainId
+}
+
+// NewTonLChainId returns the LChainId for the TON network with the given global id
+func NewTonLChainId(globalId int32) TonLChainId {
+	var inner [ChainIdLength]byte
+	inner[0] = byte(EcosystemTon)
+	binary.BigEndian.PutUint32(inner[ChainIdLength-4:], uint32(globalId))
+	return TonLChainId{
+		lChainId{inner: inner},
+	}
+}
+
+// NewTonMainnetLChainId returns the LChainId for the TON mainnet blockchain (-239)
+func NewTonMainnetLChainId() TonLChainId {
+	return NewTonLChainId(-239)
+}
+
+// NewTonTestnetLChainId returns the LChainId for the TON testnet blockchain (-3)
+func NewTonTestnetLChainId() TonLChainId {
+	return NewTonLChainId(-3)
+}
+
+// GlobalId returns the global id of the network as it is meant in the TON ecosystem
+func (c TonLChainId) GlobalId() int32 {
+	return int32(binary.BigEndian.Uint32(c.inner[ChainIdLength-4:]))
+}
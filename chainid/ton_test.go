@@ -0,0 +1,37 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestTonLChainId_NewLChainIdFromHex(t *testing.T) {
+	ch, err := chainid.NewLChainIdFromHex("0x06000000000000000000000000000000000000000000000000000000ffffff11")
+	common.AssertNoError(t, err)
+	tonCh, ok := ch.(chainid.TonLChainId)
+	common.AssertTrue(t, ok)
+	common.AssertTrue(t, tonCh.GlobalId() == -239)
+	common.AssertTrue(t, chainid.NewTonTestnetLChainId().GlobalId() == -3)
+	common.AssertTrue(t, chainid.NewTonLChainId(662387).GlobalId() == 662387)
+	common.EqualStrings(t, "ton", ch.Ecosystem().String())
+}
+
+// These tests verify that LChainId concrete types are usable as map keys.
+func TestTonLChainId_AsMapKey(t *testing.T) {
+	// Use two distinct equal instances
+	a := chainid.NewTonMainnetLChainId()
+	b := chainid.NewTonLChainId(a.GlobalId())
+	c, err := chainid.NewLChainIdFromHex(a.String())
+	common.AssertNoError(t, err)
+
+	m := map[chainid.LChainId]string{}
+	m[a] = "ok"
+
+	// same key instance
+	common.EqualStrings(t, "ok", m[a])
+	// distinct but equal value should still map to the same bucket if comparable by value
+	common.EqualStrings(t, "ok", m[b])
+	common.EqualStrings(t, "ok", m[c])
+}
@@ -0,0 +1,58 @@
+package chainid
+
+import (
+	"strings"
+
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+// TronLChainId is the LChainId of Tron networks. Like EVM chains, the least significant bytes are the chain id
+// returned by the `eth_chainId` JSON-RPC method of the network.
+type TronLChainId struct {
+	lChainId
+}
+
+// NewTronLChainId returns a new LChainId instance for the provided chain Id.
+// Chain Id must be provided in hex form either with or w/o the leading 0x
+func NewTronLChainId(id string) (TronLChainId, error) {
+	trimmed := strings.TrimPrefix(id, "0x")
+	if len(trimmed) > ChainIdAvailableLength*2 {
+		return TronLChainId{}, NewMaxErrLength(ChainIdAvailableLength*2, len(trimmed))
+	}
+	innerChainId, err := newLChainIdFromHex(
+		EcosystemTron.ToEcosystemHexByte() +
+			common.Repeated64Zeros[len(trimmed)+2:] +
+			trimmed,
+	)
+	if err != nil {
+		return TronLChainId{}, err
+	}
+	return TronLChainId{lChainId: *innerChainId}, nil
+}
+
+// NewTronMainnetLChainId returns the LChainId for the Tron mainnet blockchain (0x2b6653dc)
+func NewTronMainnetLChainId() TronLChainId {
+	return TronLChainId{
+		lChainId{
+			inner: [32]byte{0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2b, 0x66, 0x53, 0xdc},
+		},
+	}
+}
+
+// NewTronShastaLChainId returns the LChainId for the Tron Shasta testnet blockchain (0x94a9059e)
+func NewTronShastaLChainId() TronLChainId {
+	return TronLChainId{
+		lChainId{
+			inner: [32]byte{0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x94, 0xa9, 0x05, 0x9e},
+		},
+	}
+}
+
+// NewTronNileLChainId returns the LChainId for the Tron Nile testnet blockchain (0xcd8690dc)
+func NewTronNileLChainId() TronLChainId {
+	return TronLChainId{
+		lChainId{
+			inner: [32]byte{0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xcd, 0x86, 0x90, 0xdc},
+		},
+	}
+}
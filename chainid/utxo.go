@@ -0,0 +1,129 @@
+package chainid
+
+import (
+	"encoding/hex"
+	"slices"
+	"strings"
+)
+
+// UtxoGenesisHashLength is the length of the genesis block hash of Bitcoin-like chains
+const UtxoGenesisHashLength = 32
+
+// UtxoNetworkParams describes the address encoding of a Bitcoin-like UTXO network
+type UtxoNetworkParams struct {
+	// Name is the human readable name of the network
+	Name string
+	// Bech32Prefix is the human readable part of segwit addresses, empty when segwit is not supported
+	Bech32Prefix string
+	// P2PKHVersion is the base58check version byte of pay-to-pubkey-hash addresses
+	P2PKHVersion byte
+	// P2SHVersion is the base58check version byte of pay-to-script-hash addresses
+	P2SHVersion byte
+	// WIFVersion is the base58check version byte of private keys in wallet import format
+	WIFVersion byte
+	// SupportsSegwit reports whether the network accepts segwit v0 outputs (P2WPKH and P2WSH)
+	SupportsSegwit bool
+	// SupportsTaproot reports whether the network accepts segwit v1 outputs (P2TR)
+	SupportsTaproot bool
+}
+
+// Network parameters of the known UTXO networks
+var (
+	BitcoinMainnetParams = UtxoNetworkParams{
+		Name:            "bitcoin",
+		Bech32Prefix:    "bc",
+		P2PKHVersion:    0x00,
+		P2SHVersion:     0x05,
+		WIFVersion:      0x80,
+		SupportsSegwit:  true,
+		SupportsTaproot: true,
+	}
+	BitcoinSignetParams = UtxoNetworkParams{
+		Name:            "bitcoin signet",
+		Bech32Prefix:    "tb",
+		P2PKHVersion:    0x6f,
+		P2SHVersion:     0xc4,
+		WIFVersion:      0xef,
+		SupportsSegwit:  true,
+		SupportsTaproot: true,
+	}
+	LitecoinMainnetParams = UtxoNetworkParams{
+		Name:            "litecoin",
+		Bech32Prefix:    "ltc",
+		P2PKHVersion:    0x30,
+		P2SHVersion:     0x32,
+		WIFVersion:      0xb0,
+		SupportsSegwit:  true,
+		SupportsTaproot: true,
+	}
+	DogecoinMainnetParams = UtxoNetworkParams{
+		Name:         "dogecoin",
+		P2PKHVersion: 0x1e,
+		P2SHVersion:  0x16,
+		WIFVersion:   0x9e,
+	}
+)
+
+// NewUtxoLChainId generates a new Lombard Chain Id for a Bitcoin-like chain given the hex encoding of its
+// genesis block hash, in the usual display order and with or without leading 0x. The resulting Lombard Chain Id
+// is the genesis hash with its MSB replaced by the ecosystem byte, as for Bitcoin.
+func NewUtxoLChainId(genesisHash string) (BitcoinLChainId, error) {
+	var decoded [UtxoGenesisHashLength]byte
+	trimmed := strings.TrimPrefix(genesisHash, "0x")
+	if len(trimmed) != UtxoGenesisHashLength*2 {
+		return BitcoinLChainId{}, NewErrLength(UtxoGenesisHashLength, len(trimmed)/2)
+	}
+	if _, err := hex.Decode(decoded[:], []byte(trimmed)); err != nil {
+		return BitcoinLChainId{}, NewErrLChainIdInvalid(err)
+	}
+	// swap MSB with our ecosystem id
+	decoded[0] = byte(EcosystemBitcoin)
+	innerChainId, err := newLChainId(decoded[:])
+	if err != nil {
+		return BitcoinLChainId{}, err
+	}
+	return BitcoinLChainId{lChainId: *innerChainId}, nil
+}
+
+// NewLitecoinLChainId returns the LChainId for the Litecoin blockchain
+func NewLitecoinLChainId() BitcoinLChainId {
+	return BitcoinLChainId{
+		lChainId{
+			inner: [32]byte{0xff, 0xa7, 0x65, 0xe3, 0x1f, 0xfd, 0x40, 0x59, 0xba, 0xda, 0x1e, 0x25, 0x19, 0x0f, 0x6e, 0x98, 0xc9, 0x9d, 0x97, 0x14, 0xd3, 0x34, 0xef, 0xa4, 0x1a, 0x19, 0x5a, 0x7e, 0x7e, 0x04, 0xbf, 0xe2},
+		},
+	}
+}
+
+// NewDogecoinLChainId returns the LChainId for the Dogecoin blockchain
+func NewDogecoinLChainId() BitcoinLChainId {
+	return BitcoinLChainId{
+		lChainId{
+			inner: [32]byte{0xff, 0x91, 0xe3, 0xda, 0xce, 0x36, 0xe2, 0xbe, 0x3b, 0xf0, 0x30, 0xa6, 0x56, 0x79, 0xfe, 0x82, 0x1a, 0xa1, 0xd6, 0xef, 0x92, 0xe7, 0xc9, 0x90, 0x2e, 0xb3, 0x18, 0x18, 0x2c, 0x35, 0x56, 0x91},
+		},
+	}
+}
+
+// knownUtxoNetworks is the registry of the UTXO networks whose parameters are known to the library
+var knownUtxoNetworks = map[BitcoinLChainId]UtxoNetworkParams{
+	NewBitcoinLChainId():       BitcoinMainnetParams,
+	NewBitcoinSignetLChainId(): BitcoinSignetParams,
+	NewLitecoinLChainId():      LitecoinMainnetParams,
+	NewDogecoinLChainId():      DogecoinMainnetParams,
+}
+
+// NetworkParams returns the address encoding parameters of the network and whether the network is among the
+// known ones
+func (c BitcoinLChainId) NetworkParams() (UtxoNetworkParams, bool) {
+	params, ok := knownUtxoNetworks[c]
+	return params, ok
+}
+
+// KnownUtxoNetworkParams returns the parameters of all the known UTXO networks, sorted by name
+func KnownUtxoNetworkParams() []UtxoNetworkParams {
+	out := make([]UtxoNetworkParams, 0, len(knownUtxoNetworks))
+	for _, params := range knownUtxoNetworks {
+		out = append(out, params)
+	}
+	slices.SortFunc(out, func(a, b UtxoNetworkParams) int { return strings.Compare(a.Name, b.Name) })
+	return out
+}
@@ -0,0 +1,84 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestUtxoLChainId_NewUtxoLChainId(t *testing.T) {
+	ch, err := chainid.NewUtxoLChainId("0x12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2")
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, ch.Equal(chainid.NewLitecoinLChainId()))
+	common.EqualStrings(t, "bitcoin", ch.Ecosystem().String())
+
+	ch, err = chainid.NewUtxoLChainId("1a91e3dace36e2be3bf030a65679fe821aa1d6ef92e7c9902eb318182c355691")
+	common.AssertNoError(t, err)
+	common.AssertTrue(t, ch.Equal(chainid.NewDogecoinLChainId()))
+
+	fromHex, err := chainid.NewLChainIdFromHex(ch.String())
+	common.AssertNoError(t, err)
+	_, ok := fromHex.(chainid.BitcoinLChainId)
+	common.AssertTrue(t, ok)
+}
+
+func TestUtxoLChainId_InvalidGenesisHash(t *testing.T) {
+	_, err := chainid.NewUtxoLChainId("0x12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bf")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid, chainid.ErrLength)
+	_, err = chainid.NewUtxoLChainId("0xzza765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2")
+	common.AssertError(t, err, chainid.ErrLChainIdInvalid)
+}
+
+func TestUtxoLChainId_NetworkParams(t *testing.T) {
+	tests := []struct {
+		chainId chainid.BitcoinLChainId
+		params  chainid.UtxoNetworkParams
+	}{
+		{chainid.NewBitcoinLChainId(), chainid.BitcoinMainnetParams},
+		{chainid.NewBitcoinSignetLChainId(), chainid.BitcoinSignetParams},
+		{chainid.NewLitecoinLChainId(), chainid.LitecoinMainnetParams},
+		{chainid.NewDogecoinLChainId(), chainid.DogecoinMainnetParams},
+	}
+	for _, test := range tests {
+		t.Run(test.params.Name, func(t *testing.T) {
+			params, ok := test.chainId.NetworkParams()
+			common.AssertTrue(t, ok)
+			common.EqualStrings(t, test.params.Name, params.Name)
+			common.AssertTrue(t, params == test.params)
+		})
+	}
+
+	unknown, err := chainid.NewUtxoLChainId("0x000000000933ea01ad0ee984209779baaec3ced90fa3f408719526f8d77f4943")
+	common.AssertNoError(t, err)
+	_, ok := unknown.NetworkParams()
+	common.AssertFalse(t, ok)
+
+	common.AssertFalse(t, chainid.DogecoinMainnetParams.SupportsSegwit)
+	common.AssertFalse(t, chainid.DogecoinMainnetParams.SupportsTaproot)
+}
+
+// These tests verify that LChainId concrete types are usable as map keys.
+func TestUtxoLChainId_AsMapKey(t *testing.T) {
+	// Use two distinct equal instances
+	a := chainid.NewLitecoinLChainId()
+	b, err := chainid.NewUtxoLChainId("0x12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2")
+	common.AssertNoError(t, err)
+	c, err := chainid.NewLChainIdFromHex(a.String())
+	common.AssertNoError(t, err)
+
+	m := map[chainid.LChainId]string{}
+	m[a] = "ok"
+
+	common.EqualStrings(t, "ok", m[a])
+	common.EqualStrings(t, "ok", m[b])
+	common.EqualStrings(t, "ok", m[c])
+}
+
+func TestKnownUtxoNetworkParams(t *testing.T) {
+	params := chainid.KnownUtxoNetworkParams()
+	common.AssertTrue(t, len(params) == 4)
+	for i, name := range []string{"bitcoin", "bitcoin signet", "dogecoin", "litecoin"} {
+		common.EqualStrings(t, name, params[i].Name)
+	}
+}
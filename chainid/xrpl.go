@@ -0,0 +1,39 @@
+package chainid
+
+import "encoding/binary"
+
+// XrplLChainId is the LChainId of XRP Ledger networks, whose least significant 4 bytes are the network id
+// of the network as a big endian unsigned 32 bits integer
+type XrplLChainId struct {
+	lChainId
+}
+
+// NewXrplLChainId returns the LChainId for the XRP Ledger network with the given network id
+func NewXrplLChainId(networkId uint32) XrplLChainId {
+	var inner [ChainIdLength]byte
+	inner[0] = byte(EcosystemXrpl)
+	binary.BigEndian.PutUint32(inner[ChainIdLength-4:], networkId)
+	return XrplLChainId{
+		lChainId{inner: inner},
+	}
+}
+
+// NewXrplMainnetLChainId returns the LChainId for the XRP Ledger mainnet (0)
+func NewXrplMainnetLChainId() XrplLChainId {
+	return NewXrplLChainId(0)
+}
+
+// NewXrplTestnetLChainId returns the LChainId for the XRP Ledger testnet (1)
+func NewXrplTestnetLChainId() XrplLChainId {
+	return NewXrplLChainId(1)
+}
+
+// NewXrplDevnetLChainId returns the LChainId for the XRP Ledger devnet (2)
+func NewXrplDevnetLChainId() XrplLChainId {
+	return NewXrplLChainId(2)
+}
+
+// NetworkId returns the network id as it is meant in the XRP Ledger ecosystem
+func (c XrplLChainId) NetworkId() uint32 {
+	return binary.BigEndian.Uint32(c.inner[ChainIdLength-4:])
+}
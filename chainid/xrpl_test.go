@@ -0,0 +1,38 @@
+package chainid_test
+
+import (
+	"testing"
+
+	"github.com/lombard-finance/ledger-utils/chainid"
+	"github.com/lombard-finance/ledger-utils/common"
+)
+
+func TestXrplLChainId_NewLChainIdFromHex(t *testing.T) {
+	ch, err := chainid.NewLChainIdFromHex("0x0900000000000000000000000000000000000000000000000000000000000001")
+	common.AssertNoError(t, err)
+	xrplCh, ok := ch.(chainid.XrplLChainId)
+	common.AssertTrue(t, ok)
+	common.AssertTrue(t, xrplCh.NetworkId() == 1)
+	common.AssertTrue(t, xrplCh.Equal(chainid.NewXrplTestnetLChainId()))
+	common.AssertTrue(t, chainid.NewXrplLChainId(21338).NetworkId() == 21338)
+	common.EqualStrings(t, "xrpl", ch.Ecosystem().String())
+	common.AssertTrue(t, ch.Ecosystem().IsSupported())
+}
+
+// These tests verify that LChainId concrete types are usable as map keys.
+func TestXrplLChainId_AsMapKey(t *testing.T) {
+	// Use two distinct equal instances
+	a := chainid.NewXrplMainnetLChainId()
+	b := chainid.NewXrplLChainId(a.NetworkId())
+	c, err := chainid.NewLChainIdFromHex(a.String())
+	common.AssertNoError(t, err)
+
+	m := map[chainid.LChainId]string{}
+	m[a] = "ok"
+
+	// same key instance
+	common.EqualStrings(t, "ok", m[a])
+	// distinct but equal value should still map to the same bucket if comparable by value
+	common.EqualStrings(t, "ok", m[b])
+	common.EqualStrings(t, "ok", m[c])
+}
@@ -8,6 +8,9 @@ import (
 	"fmt"
 )
 
+var ErrInvalidLength = fmt.Errorf("invalid length")
+var ErrInvalidCharacter = fmt.Errorf("invalid character")
+
 // Encode encodes the passed bytes into a base58 encoded string.
 func Encode(bin []byte) string {
 	return FastBase58EncodingAlphabet(bin, BTCAlphabet)
@@ -91,7 +94,7 @@ func FastBase58Decoding(str string) ([]byte, error) {
 // b58 alphabet.
 func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error) {
 	if len(str) == 0 {
-		return nil, fmt.Errorf("zero length string")
+		return nil, fmt.Errorf("%w: zero length string", ErrInvalidLength)
 	}
 
 	zero := alphabet.encode[0]
@@ -110,10 +113,10 @@ func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error)
 
 	for _, r := range str {
 		if r > 127 {
-			return nil, fmt.Errorf("high-bit set on invalid digit")
+			return nil, fmt.Errorf("%w: high-bit set on invalid digit", ErrInvalidCharacter)
 		}
 		if alphabet.decode[r] == -1 {
-			return nil, fmt.Errorf("invalid base58 digit (%q)", r)
+			return nil, fmt.Errorf("%w: invalid base58 digit (%q)", ErrInvalidCharacter, r)
 		}
 
 		c = uint64(alphabet.decode[r])
@@ -0,0 +1,141 @@
+package base58
+
+import (
+	"fmt"
+	"slices"
+)
+
+// MaxEncodedLen returns the maximum length of the base58 encoding of n bytes. It is an integer simplification
+// of ceil(n*log(256)/log(58)), which also covers the case of all zero bytes encoded one character each.
+func MaxEncodedLen(n int) int {
+	return n*555/406 + 1
+}
+
+// AppendEncode appends the base58 encoding of bin, with the bitcoin alphabet, to dst and returns the extended
+// buffer. No allocation happens if dst has at least MaxEncodedLen(len(bin)) bytes of spare capacity.
+func AppendEncode(dst []byte, bin []byte) []byte {
+	return AppendEncodeAlphabet(dst, bin, BTCAlphabet)
+}
+
+// AppendEncodeAlphabet appends the base58 encoding of bin, with the passed alphabet, to dst and returns the
+// extended buffer. No allocation happens if dst has at least MaxEncodedLen(len(bin)) bytes of spare capacity.
+func AppendEncodeAlphabet(dst []byte, bin []byte, alphabet *Alphabet) []byte {
+	zcount := 0
+	for zcount < len(bin) && bin[zcount] == 0 {
+		zcount++
+	}
+	size := zcount + MaxEncodedLen(len(bin)-zcount)
+
+	start := len(dst)
+	dst = slices.Grow(dst, size)[:start+size]
+	out := dst[start:]
+	clear(out)
+
+	// same algorithm of FastBase58EncodingAlphabet, working on the tail of dst
+	high := size - 1
+	for _, b := range bin {
+		i := size - 1
+		for carry := uint32(b); i > high || carry != 0; i-- {
+			carry = carry + 256*uint32(out[i])
+			out[i] = byte(carry % 58)
+			carry /= 58
+		}
+		high = i
+	}
+
+	i := zcount
+	for i < size && out[i] == 0 {
+		i++
+	}
+
+	// shift the digits left, over the extra zero-gap, while mapping them to the alphabet
+	val := out[i-zcount:]
+	for j := range val {
+		out[j] = alphabet.encode[val[j]]
+	}
+	return dst[:start+len(val)]
+}
+
+// DecodeInto decodes the base58 string, encoded with the bitcoin alphabet, into dst and returns the amount of
+// bytes written. See DecodeIntoAlphabet.
+func DecodeInto(dst []byte, str string) (int, error) {
+	return DecodeIntoAlphabet(dst, str, BTCAlphabet)
+}
+
+// DecodeIntoAlphabet decodes the base58 string, encoded with the passed alphabet, into dst and returns the
+// amount of bytes written at the beginning of dst. Strings longer than MaxEncodedLen(len(dst)) are rejected
+// before any decoding happens, so that the cost of decoding is bounded by the size of dst, and no allocation
+// happens. An error is returned if the decoded value does not fit in dst.
+func DecodeIntoAlphabet(dst []byte, str string, alphabet *Alphabet) (int, error) {
+	if len(str) == 0 {
+		return 0, fmt.Errorf("%w: zero length string", ErrInvalidLength)
+	}
+	if len(str) > MaxEncodedLen(len(dst)) {
+		return 0, fmt.Errorf(
+			"%w: max %d characters to decode %d bytes, got %d",
+			ErrInvalidLength, MaxEncodedLen(len(dst)), len(dst), len(str),
+		)
+	}
+
+	zero := alphabet.encode[0]
+	zcount := 0
+	for zcount < len(str) && str[zcount] == zero {
+		zcount++
+	}
+
+	clear(dst)
+	size := len(dst)
+	// the decoded value is accumulated in big endian in dst[high:]
+	high := size
+	for i := zcount; i < len(str); i++ {
+		c := str[i]
+		if c > 127 {
+			return 0, fmt.Errorf("%w: high-bit set on invalid digit", ErrInvalidCharacter)
+		}
+		if alphabet.decode[c] == -1 {
+			return 0, fmt.Errorf("%w: invalid base58 digit (%q)", ErrInvalidCharacter, c)
+		}
+		j := size - 1
+		for carry := uint32(alphabet.decode[c]); j >= high || carry != 0; j-- {
+			if j < 0 {
+				return 0, fmt.Errorf("%w: decoded value exceeds %d bytes", ErrInvalidLength, size)
+			}
+			carry += 58 * uint32(dst[j])
+			dst[j] = byte(carry)
+			carry >>= 8
+		}
+		high = j + 1
+	}
+	for high < size && dst[high] == 0 {
+		high++
+	}
+
+	written := zcount + size - high
+	if written > size {
+		return 0, fmt.Errorf("%w: decoded value exceeds %d bytes", ErrInvalidLength, size)
+	}
+	// move the value right after the leading zeroes, which are already in place
+	copy(dst[zcount:], dst[high:])
+	clear(dst[written:])
+	return written, nil
+}
+
+// DecodeFixed decodes the base58 string, encoded with the bitcoin alphabet, which must represent exactly n
+// bytes. See DecodeFixedAlphabet.
+func DecodeFixed(str string, n int) ([]byte, error) {
+	return DecodeFixedAlphabet(str, n, BTCAlphabet)
+}
+
+// DecodeFixedAlphabet decodes the base58 string, encoded with the passed alphabet, which must represent exactly
+// n bytes. Strings longer than MaxEncodedLen(n) are rejected before any decoding happens.
+func DecodeFixedAlphabet(str string, n int, alphabet *Alphabet) ([]byte, error) {
+	out := make([]byte, n)
+	written, err := DecodeIntoAlphabet(out, str, alphabet)
+	if err != nil {
+		return nil, err
+	}
+	if written != n {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidLength, n, written)
+	}
+	return out, nil
+}
@@ -0,0 +1,144 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestBoundedEqFastEncodingAndDecoding(t *testing.T) {
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet, RippleAlphabet, randAlphabet()} {
+		for j := 1; j < 128; j++ {
+			b := make([]byte, j)
+			for i := 0; i < 20; i++ {
+				rand.Read(b)
+				// exercise leading zeroes as well
+				b[0] = 0
+				if i%2 == 0 {
+					b[j/2] = 0
+				}
+				fe := FastBase58EncodingAlphabet(b, alph)
+				ae := string(AppendEncodeAlphabet([]byte("prefix"), b, alph))
+				if "prefix"+fe != ae {
+					t.Fatalf("encoding err: %s != %s", fe, ae)
+				}
+
+				dst := make([]byte, j+3)
+				n, err := DecodeIntoAlphabet(dst, fe, alph)
+				if err != nil {
+					t.Fatalf("decode into error: %v", err)
+				}
+				if !bytes.Equal(b, dst[:n]) {
+					t.Fatalf("decoding err: %x != %x", b, dst[:n])
+				}
+
+				fd, err := DecodeFixedAlphabet(fe, j, alph)
+				if err != nil {
+					t.Fatalf("decode fixed error: %v", err)
+				}
+				if !bytes.Equal(b, fd) {
+					t.Fatalf("decoding err: %x != %x", b, fd)
+				}
+			}
+		}
+	}
+}
+
+func TestBoundedDecodingErrors(t *testing.T) {
+	key := "14grJpemFaf88c8tiVb77W7TYg2W3ir6pfkKz3YjhhZ5"
+
+	// too long input is rejected upfront
+	_, err := DecodeFixed(strings.Repeat("z", 1<<20), 32)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("expected length error, given %v", err)
+	}
+	// fits the max length but the value overflows the destination
+	_, err = DecodeFixed(strings.Repeat("z", MaxEncodedLen(32)), 32)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("expected length error, given %v", err)
+	}
+	// decoded value shorter than expected
+	_, err = DecodeFixed(key[5:], 32)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("expected length error, given %v", err)
+	}
+	// too many leading zeroes
+	_, err = DecodeFixed(strings.Repeat("1", 33), 32)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("expected length error, given %v", err)
+	}
+	_, err = DecodeFixed("", 32)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("expected length error, given %v", err)
+	}
+	_, err = DecodeFixed(key[:5]+"0"+key[6:], 32)
+	if !errors.Is(err, ErrInvalidCharacter) {
+		t.Errorf("expected character error, given %v", err)
+	}
+	_, err = DecodeFixed(key[:5]+"é"+key[7:], 32)
+	if !errors.Is(err, ErrInvalidCharacter) {
+		t.Errorf("expected character error, given %v", err)
+	}
+
+	// all zeroes
+	decoded, err := DecodeFixed(strings.Repeat("1", 32), 32)
+	if err != nil || !bytes.Equal(decoded, make([]byte, 32)) {
+		t.Errorf("unexpected decoding %x, error %v", decoded, err)
+	}
+}
+
+var benchmarkKey = func() []byte {
+	key := make([]byte, 32)
+	rand.Read(key)
+	return key
+}()
+
+func BenchmarkFastBase58Encoding32(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FastBase58Encoding(benchmarkKey)
+	}
+}
+
+func BenchmarkAppendEncode32(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, MaxEncodedLen(32))
+	for i := 0; i < b.N; i++ {
+		AppendEncode(buf[:0], benchmarkKey)
+	}
+}
+
+func BenchmarkFastBase58Decoding32(b *testing.B) {
+	encoded := Encode(benchmarkKey)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FastBase58Decoding(encoded)
+	}
+}
+
+func BenchmarkDecodeInto32(b *testing.B) {
+	encoded := Encode(benchmarkKey)
+	var dst [32]byte
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DecodeInto(dst[:], encoded)
+	}
+}
+
+func BenchmarkFastBase58DecodingLongInput(b *testing.B) {
+	encoded := strings.Repeat("z", 1<<14)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FastBase58Decoding(encoded)
+	}
+}
+
+func BenchmarkDecodeFixedLongInput(b *testing.B) {
+	encoded := strings.Repeat("z", 1<<14)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DecodeFixed(encoded, 32)
+	}
+}
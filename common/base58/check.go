@@ -0,0 +1,69 @@
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// ChecksumLength is the length of the Base58Check checksum
+const ChecksumLength = 4
+
+var ErrChecksum = fmt.Errorf("checksum error")
+
+// checksum returns the first 4 bytes of the double sha256 of version || payload
+func checksum(version []byte, payload []byte) [ChecksumLength]byte {
+	h := sha256.New()
+	h.Write(version)
+	h.Write(payload)
+	first := h.Sum(nil)
+	second := sha256.Sum256(first)
+	var out [ChecksumLength]byte
+	copy(out[:], second[:ChecksumLength])
+	return out
+}
+
+// CheckEncode encodes version || payload || checksum with the bitcoin alphabet, where the checksum is the
+// first 4 bytes of the double sha256 of version || payload.
+func CheckEncode(version []byte, payload []byte) string {
+	return CheckEncodeAlphabet(version, payload, BTCAlphabet)
+}
+
+// CheckEncodeAlphabet encodes version || payload || checksum with the passed alphabet, where the checksum is
+// the first 4 bytes of the double sha256 of version || payload.
+func CheckEncodeAlphabet(version []byte, payload []byte, alphabet *Alphabet) string {
+	cs := checksum(version, payload)
+	b := make([]byte, 0, len(version)+len(payload)+ChecksumLength)
+	b = append(b, version...)
+	b = append(b, payload...)
+	b = append(b, cs[:]...)
+	return FastBase58EncodingAlphabet(b, alphabet)
+}
+
+// CheckDecode decodes a Base58Check string encoded with the bitcoin alphabet, returning the first
+// versionLength bytes as version and the remaining bytes before the checksum as payload.
+func CheckDecode(str string, versionLength int) ([]byte, []byte, error) {
+	return CheckDecodeAlphabet(str, versionLength, BTCAlphabet)
+}
+
+// CheckDecodeAlphabet decodes a Base58Check string encoded with the passed alphabet, returning the first
+// versionLength bytes as version and the remaining bytes before the checksum as payload.
+func CheckDecodeAlphabet(str string, versionLength int, alphabet *Alphabet) ([]byte, []byte, error) {
+	decoded, err := FastBase58DecodingAlphabet(str, alphabet)
+	if err != nil {
+		return nil, nil, err
+	}
+	if versionLength < 0 || len(decoded) < versionLength+ChecksumLength {
+		return nil, nil, fmt.Errorf(
+			"%w: expected at least %d bytes, got %d",
+			ErrInvalidLength, versionLength+ChecksumLength, len(decoded),
+		)
+	}
+	version := decoded[:versionLength]
+	payload := decoded[versionLength : len(decoded)-ChecksumLength]
+	cs := checksum(version, payload)
+	if !bytes.Equal(cs[:], decoded[len(decoded)-ChecksumLength:]) {
+		return nil, nil, ErrChecksum
+	}
+	return version, payload, nil
+}
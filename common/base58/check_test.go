@@ -0,0 +1,95 @@
+package base58
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestCheckEncodingAndDecoding(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoded  string
+		version  string
+		payload  string
+		alphabet *Alphabet
+	}{
+		{
+			"bitcoin P2PKH address",
+			"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+			"00",
+			"62e907b15cbf27d5425399ebf6f0fb50ebb88f18",
+			BTCAlphabet,
+		},
+		{
+			"bitcoin WIF key",
+			"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ",
+			"80",
+			"0c28fca386c7a227600b2fe50b7cae11ec86d3bf1fbe471be89827e19d72aa1d",
+			BTCAlphabet,
+		},
+		{
+			"xrpl genesis account",
+			"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			"00",
+			"b5f762798a53d543a014caf8b297cff8f2f937e8",
+			RippleAlphabet,
+		},
+		{
+			"flickr alphabet",
+			"1a1Zo1Do5pgDEH2dmosEsk5rkLV7dHVEnz",
+			"00",
+			"62e907b15cbf27d5425399ebf6f0fb50ebb88f18",
+			FlickrAlphabet,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, _ := hex.DecodeString(tt.version)
+			payload, _ := hex.DecodeString(tt.payload)
+			encoded := CheckEncodeAlphabet(version, payload, tt.alphabet)
+			if encoded != tt.encoded {
+				t.Errorf("expected: %s actual: %s", tt.encoded, encoded)
+			}
+			decodedVersion, decodedPayload, err := CheckDecodeAlphabet(tt.encoded, len(version), tt.alphabet)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hex.EncodeToString(decodedVersion) != tt.version {
+				t.Errorf("expected: %s actual: %x", tt.version, decodedVersion)
+			}
+			if hex.EncodeToString(decodedPayload) != tt.payload {
+				t.Errorf("expected: %s actual: %x", tt.payload, decodedPayload)
+			}
+		})
+	}
+}
+
+func TestCheckDecodingErrors(t *testing.T) {
+	valid := "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"
+
+	// last character altered
+	_, _, err := CheckDecode(valid[:len(valid)-1]+"b", 1)
+	if !errors.Is(err, ErrChecksum) {
+		t.Errorf("expected checksum error, given %v", err)
+	}
+	// too short to contain version and checksum
+	_, _, err = CheckDecode("1111", 1)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("expected length error, given %v", err)
+	}
+	_, _, err = CheckDecode("", 1)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("expected length error, given %v", err)
+	}
+	// '0' is not part of the alphabet
+	_, _, err = CheckDecode("0"+valid[1:], 1)
+	if !errors.Is(err, ErrInvalidCharacter) {
+		t.Errorf("expected character error, given %v", err)
+	}
+	// valid with bitcoin alphabet but not with ripple one, whose checksum does not match
+	_, _, err = CheckDecodeAlphabet(valid, 1, RippleAlphabet)
+	if !errors.Is(err, ErrChecksum) {
+		t.Errorf("expected checksum error, given %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+// Package bech32 implements the bech32 (BIP-173) and bech32m (BIP-350) encodings used by Cosmos SDK chains,
+// Bitcoin segwit outputs and other ecosystems to render addresses with a human readable part.
+package bech32
+
+import (
+	"fmt"
+	"strings"
+)
+
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// MaxLength is the maximum length of a bech32 string according to BIP-173
+const MaxLength = 90
+
+// checksumLength is the amount of 5-bit groups used by the checksum
+const checksumLength = 6
+
+// Variant identifies the checksum constant used by the encoding
+type Variant uint32
+
+const (
+	// Bech32 is the original encoding defined in BIP-173
+	Bech32 Variant = 1
+	// Bech32m is the encoding defined in BIP-350, used by segwit v1+ outputs
+	Bech32m Variant = 0x2bc830a3
+)
+
+func (v Variant) String() string {
+	switch v {
+	case Bech32:
+		return "bech32"
+	case Bech32m:
+		return "bech32m"
+	default:
+		return fmt.Sprintf("variant %x", uint32(v))
+	}
+}
+
+var ErrInvalidBech32 = fmt.Errorf("invalid bech32 string")
+var ErrInvalidChecksum = fmt.Errorf("%w: invalid checksum", ErrInvalidBech32)
+var ErrInvalidLength = fmt.Errorf("%w: invalid length", ErrInvalidBech32)
+var ErrInvalidCharacter = fmt.Errorf("%w: invalid character", ErrInvalidBech32)
+var ErrMixedCase = fmt.Errorf("%w: mixed case", ErrInvalidBech32)
+var ErrInvalidPadding = fmt.Errorf("%w: invalid padding", ErrInvalidBech32)
+
+var charsetRev = func() [128]int8 {
+	var rev [128]int8
+	for i := range rev {
+		rev[i] = -1
+	}
+	for i, c := range charset {
+		rev[c] = int8(i)
+	}
+	return rev
+}()
+
+func polymod(values []byte, chk uint32) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func hrpExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+func createChecksum(hrp string, data []byte, v Variant) []byte {
+	values := append(hrpExpand(hrp), data...)
+	values = append(values, make([]byte, checksumLength)...)
+	mod := polymod(values, 1) ^ uint32(v)
+	out := make([]byte, checksumLength)
+	for i := range out {
+		out[i] = byte(mod>>(5*(5-i))) & 31
+	}
+	return out
+}
+
+// EncodeFromBase32 encodes hrp and data, already grouped in 5-bit values, with the given variant.
+func EncodeFromBase32(hrp string, data []byte, v Variant) (string, error) {
+	if len(hrp) == 0 {
+		return "", fmt.Errorf("%w: empty human readable part", ErrInvalidBech32)
+	}
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", fmt.Errorf("%w: human readable part at index %d", ErrInvalidCharacter, i)
+		}
+	}
+	hrp = strings.ToLower(hrp)
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(data) + checksumLength)
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, d := range data {
+		if d > 31 {
+			return "", fmt.Errorf("%w: data value %d exceeds 5 bits", ErrInvalidCharacter, d)
+		}
+		sb.WriteByte(charset[d])
+	}
+	for _, d := range createChecksum(hrp, data, v) {
+		sb.WriteByte(charset[d])
+	}
+	return sb.String(), nil
+}
+
+// DecodeToBase32 decodes a bech32 or bech32m string of at most maxLength characters, returning the lower case
+// human readable part, the data grouped in 5-bit values and the detected variant.
+func DecodeToBase32(s string, maxLength int) (string, []byte, Variant, error) {
+	if len(s) > maxLength {
+		return "", nil, 0, fmt.Errorf("%w: max %d characters, given %d", ErrInvalidLength, maxLength, len(s))
+	}
+	lower := strings.ToLower(s)
+	if lower != s && strings.ToUpper(s) != s {
+		return "", nil, 0, ErrMixedCase
+	}
+	sep := strings.LastIndexByte(lower, '1')
+	if sep < 1 || sep+checksumLength+1 > len(lower) {
+		return "", nil, 0, fmt.Errorf("%w: separator misplaced or missing", ErrInvalidBech32)
+	}
+	hrp := lower[:sep]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, fmt.Errorf("%w: human readable part at index %d", ErrInvalidCharacter, i)
+		}
+	}
+	data := make([]byte, 0, len(lower)-sep-1)
+	for i := sep + 1; i < len(lower); i++ {
+		c := lower[i]
+		if c > 127 || charsetRev[c] == -1 {
+			return "", nil, 0, fmt.Errorf("%w: %q at index %d", ErrInvalidCharacter, c, i)
+		}
+		data = append(data, byte(charsetRev[c]))
+	}
+	switch Variant(polymod(append(hrpExpand(hrp), data...), 1)) {
+	case Bech32:
+		return hrp, data[:len(data)-checksumLength], Bech32, nil
+	case Bech32m:
+		return hrp, data[:len(data)-checksumLength], Bech32m, nil
+	default:
+		return "", nil, 0, ErrInvalidChecksum
+	}
+}
+
+// ConvertBits regroups data from groups of fromBits bits into groups of toBits bits. When pad is false, the
+// input must not leave a non-zero remainder, as required when converting back to 8-bit values.
+func ConvertBits(data []byte, fromBits uint, toBits uint, pad bool) ([]byte, error) {
+	var acc uint32
+	var bits uint
+	maxv := uint32(1)<<toBits - 1
+	out := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, b := range data {
+		if uint32(b)>>fromBits != 0 {
+			return nil, fmt.Errorf("%w: value %d exceeds %d bits", ErrInvalidCharacter, b, fromBits)
+		}
+		acc = acc<<fromBits | uint32(b)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, ErrInvalidPadding
+	}
+	return out, nil
+}
+
+// Encode encodes the 8-bit data with the bech32 variant using the provided human readable part.
+func Encode(hrp string, data []byte) (string, error) {
+	return EncodeVariant(hrp, data, Bech32)
+}
+
+// EncodeVariant encodes the 8-bit data with the given variant using the provided human readable part.
+func EncodeVariant(hrp string, data []byte, v Variant) (string, error) {
+	converted, err := ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return EncodeFromBase32(hrp, converted, v)
+}
+
+// Decode decodes a bech32 string returning its human readable part and the 8-bit data. Strings using the
+// bech32m variant are rejected.
+func Decode(s string) (string, []byte, error) {
+	hrp, data, v, err := DecodeVariant(s, MaxLength)
+	if err != nil {
+		return "", nil, err
+	}
+	if v != Bech32 {
+		return "", nil, fmt.Errorf("%w: expected %s, given %s", ErrInvalidChecksum, Bech32, v)
+	}
+	return hrp, data, nil
+}
+
+// DecodeVariant decodes a bech32 or bech32m string of at most maxLength characters returning its human readable
+// part, the 8-bit data and the detected variant.
+func DecodeVariant(s string, maxLength int) (string, []byte, Variant, error) {
+	hrp, data, v, err := DecodeToBase32(s, maxLength)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	converted, err := ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	return hrp, converted, v, nil
+}
@@ -0,0 +1,94 @@
+package bech32
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidChecksums(t *testing.T) {
+	tests := []struct {
+		s string
+		v Variant
+	}{
+		// BIP-173 vectors
+		{"A12UEL5L", Bech32},
+		{"a12uel5l", Bech32},
+		{"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs", Bech32},
+		{"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", Bech32},
+		{"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w", Bech32},
+		{"?1ezyfcl", Bech32},
+		// BIP-350 vectors
+		{"A1LQFN3A", Bech32m},
+		{"a1lqfn3a", Bech32m},
+		{"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", Bech32m},
+		{"split1checkupstagehandshakeupstreamerranterredcaperredlc445v", Bech32m},
+		{"?1v759aa", Bech32m},
+	}
+	for _, tt := range tests {
+		hrp, data, v, err := DecodeToBase32(tt.s, MaxLength)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.s, err)
+			continue
+		}
+		if v != tt.v {
+			t.Errorf("%s: expected variant %s, given %s", tt.s, tt.v, v)
+		}
+		encoded, err := EncodeFromBase32(hrp, data, v)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.s, err)
+			continue
+		}
+		if encoded != strings.ToLower(tt.s) {
+			t.Errorf("expected: %s actual: %s", strings.ToLower(tt.s), encoded)
+		}
+	}
+}
+
+func TestInvalidStrings(t *testing.T) {
+	tests := []struct {
+		s   string
+		err error
+	}{
+		{"an84characterslonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1569pvx", ErrInvalidLength},
+		{"pzry9x0s0muk", ErrInvalidBech32},
+		{"1pzry9x0s0muk", ErrInvalidBech32},
+		{"x1b4n0q5v", ErrInvalidCharacter},
+		{"li1dgmt3", ErrInvalidBech32},
+		{"A1G7SGD8", ErrInvalidChecksum},
+		{"10a06t8", ErrInvalidBech32},
+		{"1qzzfhee", ErrInvalidBech32},
+		{"a12UEL5L", ErrMixedCase},
+	}
+	for _, tt := range tests {
+		_, _, _, err := DecodeToBase32(tt.s, MaxLength)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: expected error %v, given %v", tt.s, tt.err, err)
+		}
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	address := "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
+	hrp, data, err := Decode(address)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if hrp != "cosmos" || hex.EncodeToString(data) != "4fea76427b8345861e80a3540a8a9d936fd39391" {
+		t.Errorf("unexpected decoding %s %x", hrp, data)
+	}
+	encoded, err := Encode(hrp, data)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if encoded != address {
+		t.Errorf("expected: %s actual: %s", address, encoded)
+	}
+
+	// bech32m strings are rejected by Decode
+	_, _, err = Decode("abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx")
+	if !errors.Is(err, ErrInvalidChecksum) {
+		t.Errorf("expected error %v, given %v", ErrInvalidChecksum, err)
+	}
+}
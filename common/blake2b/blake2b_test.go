@@ -0,0 +1,47 @@
+package blake2b
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	// last two inputs exercise the boundary between the last full block and the final block
+	tests := []struct {
+		data        []byte
+		expected512 string
+		expected256 string
+	}{
+		{
+			[]byte(""),
+			"786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce",
+			"0e5751c026e543b2e8ab2eb06099daa1d1e5df47778f7787faab45cdf12fe3a8",
+		},
+		{
+			[]byte("abc"),
+			"ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923",
+			"bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319",
+		},
+		{
+			bytes.Repeat([]byte("a"), BlockSize),
+			"fc6c71f688f43ea7d60817478808f3cac753e61571865c95adbc2d9122c943a76b92c2cb1047ef3fe7bf6e436ec1d0a99a9e5b216780bf7fed9d7ca91d3a8f3b",
+			"ae2aa48507885c4c950fb809b2076f959cde9f8ea6da260d9a3587df33dac450",
+		},
+		{
+			bytes.Repeat([]byte("a"), BlockSize+1),
+			"55e6e0eb418149a8af92fd9ddc99254781b2f522a131b4f4d984404b71a00e1167b8124d5dcddd4c6977b299392335d6edd303da6d344d74bbef2d38101b232b",
+			"2f64744a6de0d2c0b56e64cf6e29a5aaa255010d415d51c75ccc82f73dccd865",
+		},
+	}
+	for _, tt := range tests {
+		actual512 := Sum512(tt.data)
+		if actual := hex.EncodeToString(actual512[:]); actual != tt.expected512 {
+			t.Errorf("%d bytes: expected: %s actual: %s", len(tt.data), tt.expected512, actual)
+		}
+		actual256 := Sum256(tt.data)
+		if actual := hex.EncodeToString(actual256[:]); actual != tt.expected256 {
+			t.Errorf("%d bytes: expected: %s actual: %s", len(tt.data), tt.expected256, actual)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Package crc16 implements the CRC-16/XMODEM checksum, used by TON user-friendly addresses and Stellar StrKeys.
+package crc16
+
+// xmodemPoly is the CRC-16/XMODEM polynomial x^16 + x^12 + x^5 + 1
+const xmodemPoly = 0x1021
+
+var xmodemTable = func() [256]uint16 {
+	var table [256]uint16
+	for i := range table {
+		crc := uint16(i) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ xmodemPoly
+			} else {
+				crc <<= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}()
+
+// XModem returns the CRC-16/XMODEM checksum of data, i.e. the CCITT polynomial with zero initial value and
+// no final xor.
+func XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc = crc<<8 ^ xmodemTable[byte(crc>>8)^b]
+	}
+	return crc
+}
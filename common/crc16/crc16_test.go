@@ -0,0 +1,19 @@
+package crc16
+
+import "testing"
+
+func TestXModem(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected uint16
+	}{
+		{"", 0x0000},
+		{"123456789", 0x31c3},
+		{"A", 0x58e5},
+	}
+	for _, tt := range tests {
+		if actual := XModem([]byte(tt.data)); actual != tt.expected {
+			t.Errorf("%q: expected: %04x actual: %04x", tt.data, tt.expected, actual)
+		}
+	}
+}
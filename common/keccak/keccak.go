@@ -0,0 +1,93 @@
+// Package keccak implements the Keccak-256 hash function as used by Ethereum, i.e. with the original Keccak padding
+// rather than the SHA-3 one of FIPS 202, used by EIP-55 address checksums.
+package keccak
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	// Rate is the rate of Keccak-256 in bytes, i.e. the amount of input absorbed per permutation
+	Rate = 136
+	// Size is the size of a Keccak-256 digest in bytes
+	Size = 32
+)
+
+var roundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+	0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+	0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// rotations are the rotation offsets of the rho step, indexed by lane x+5*y
+var rotations = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Sum256 returns the Keccak-256 digest of data
+func Sum256(data []byte) [Size]byte {
+	var state [25]uint64
+	for len(data) >= Rate {
+		absorb(&state, data[:Rate])
+		data = data[Rate:]
+	}
+	// original Keccak padding: 0x01, zeroes, and 0x80 in the last byte of the block
+	var last [Rate]byte
+	copy(last[:], data)
+	last[len(data)] ^= 0x01
+	last[Rate-1] ^= 0x80
+	absorb(&state, last[:])
+
+	var out [Size]byte
+	for i := 0; i < Size/8; i++ {
+		binary.LittleEndian.PutUint64(out[i*8:], state[i])
+	}
+	return out
+}
+
+func absorb(state *[25]uint64, block []byte) {
+	for i := 0; i < Rate/8; i++ {
+		state[i] ^= binary.LittleEndian.Uint64(block[i*8:])
+	}
+	permute(state)
+}
+
+// permute applies the Keccak-f[1600] permutation
+func permute(a *[25]uint64) {
+	var c [5]uint64
+	var b [25]uint64
+	for _, rc := range roundConstants {
+		// theta
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+			for y := 0; y < 25; y += 5 {
+				a[x+y] ^= d
+			}
+		}
+		// rho and pi
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(a[x+5*y], rotations[x+5*y])
+			}
+		}
+		// chi
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[x+y] = b[x+y] ^ (^b[(x+1)%5+y] & b[(x+2)%5+y])
+			}
+		}
+		// iota
+		a[0] ^= rc
+	}
+}
@@ -0,0 +1,26 @@
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestSum256(t *testing.T) {
+	// last two inputs exercise the boundary between the last full block and the padding block
+	tests := []struct {
+		data     []byte
+		expected string
+	}{
+		{[]byte(""), "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{[]byte("abc"), "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+		{bytes.Repeat([]byte("a"), Rate-1), "34367dc248bbd832f4e3e69dfaac2f92638bd0bbd18f2912ba4ef454919cf446"},
+		{bytes.Repeat([]byte("a"), Rate), "a6c4d403279fe3e0af03729caada8374b5ca54d8065329a3ebcaeb4b60aa386e"},
+	}
+	for _, tt := range tests {
+		digest := Sum256(tt.data)
+		if actual := hex.EncodeToString(digest[:]); actual != tt.expected {
+			t.Errorf("%d bytes: expected: %s actual: %s", len(tt.data), tt.expected, actual)
+		}
+	}
+}